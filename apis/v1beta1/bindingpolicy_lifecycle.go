@@ -0,0 +1,77 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/vmware-labs/reconciler-runtime/apis"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// These are valid conditions of BindingPolicy.
+const (
+	// BindingPolicyConditionReady means each ServiceBinding generated for the policy is ready.
+	BindingPolicyConditionReady = apis.ConditionReady
+	// BindingPolicyConditionServiceBindingsReady means each ServiceBinding generated for the policy has projected its
+	// binding into the selected workloads.
+	BindingPolicyConditionServiceBindingsReady = "ServiceBindingsReady"
+)
+
+var bindingpolicyCondSet = apis.NewLivingConditionSetWithHappyReason(
+	"PolicyApplied",
+	BindingPolicyConditionServiceBindingsReady,
+)
+
+func (s *BindingPolicy) GetConditionsAccessor() apis.ConditionsAccessor {
+	return &s.Status
+}
+
+func (s *BindingPolicy) GetConditionSet() apis.ConditionSet {
+	return bindingpolicyCondSet
+}
+
+func (s *BindingPolicy) GetConditionManager() apis.ConditionManager {
+	return bindingpolicyCondSet.Manage(&s.Status)
+}
+
+func (s *BindingPolicyStatus) InitializeConditions() {
+	conditionManager := bindingpolicyCondSet.Manage(s)
+	conditionManager.InitializeConditions()
+	// reset existing managed conditions
+	conditionManager.MarkUnknown(BindingPolicyConditionServiceBindingsReady, "Initializing", "")
+}
+
+var _ apis.ConditionsAccessor = (*BindingPolicyStatus)(nil)
+
+// GetConditions implements ConditionsAccessor
+func (s *BindingPolicyStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+// SetConditions implements ConditionsAccessor
+func (s *BindingPolicyStatus) SetConditions(c []metav1.Condition) {
+	s.Conditions = c
+}
+
+// GetCondition fetches the condition of the specified type.
+func (s *BindingPolicyStatus) GetCondition(t string) *metav1.Condition {
+	for _, cond := range s.Conditions {
+		if cond.Type == t {
+			return &cond
+		}
+	}
+	return nil
+}
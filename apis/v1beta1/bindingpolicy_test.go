@@ -0,0 +1,232 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestBindingPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *BindingPolicy
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &BindingPolicy{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "workloads and bindings",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Workloads: []BindingPolicyWorkloadSelector{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"app": "my",
+								},
+							},
+							Containers: []string{"app"},
+						},
+					},
+					Bindings: []BindingPolicyBinding{
+						{
+							Name: "db",
+							Type: "postgresql",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-db",
+							},
+							Env: []EnvMapping{
+								{
+									Name: "DB_HOST",
+									Key:  "host",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "empty workload is invalid",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Workloads: []BindingPolicyWorkloadSelector{
+						{},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "workloads").Index(0).Child("apiVersion"), ""),
+				field.Required(field.NewPath("spec", "workloads").Index(0).Child("kind"), ""),
+			},
+		},
+		{
+			name: "invalid workload selector",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Workloads: []BindingPolicyWorkloadSelector{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Selector: metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{
+										Key:      "app",
+										Operator: metav1.LabelSelectorOpIn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "workloads").Index(0).Child("selector"),
+					metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "app",
+								Operator: metav1.LabelSelectorOpIn,
+							},
+						},
+					},
+					"values: Invalid value: []string(nil): for 'in', 'notin' operators, values set can't be empty",
+				),
+			},
+		},
+		{
+			name: "empty binding is invalid",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Bindings: []BindingPolicyBinding{
+						{},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("name"), ""),
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("service", "apiVersion"), ""),
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("service", "kind"), ""),
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("service", "[name, selector]"), "expected exactly one, got neither"),
+			},
+		},
+		{
+			name: "invalid binding name",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Bindings: []BindingPolicyBinding{
+						{
+							Name: "My_DB",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-db",
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "bindings").Index(0).Child("name"), "My_DB", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		{
+			name: "duplicate binding name",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Bindings: []BindingPolicyBinding{
+						{
+							Name: "db",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-db",
+							},
+						},
+						{
+							Name: "db",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-other-db",
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "bindings", "[0, 1]", "name"), "db"),
+			},
+		},
+		{
+			name: "invalid env",
+			seed: &BindingPolicy{
+				Spec: BindingPolicySpec{
+					Bindings: []BindingPolicyBinding{
+						{
+							Name: "db",
+							Service: ServiceBindingServiceReference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-db",
+							},
+							Env: []EnvMapping{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("env").Index(0).Child("name"), ""),
+				field.Required(field.NewPath("spec", "bindings").Index(0).Child("env").Index(0).Child("key"), ""),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingPolicyWorkloadSelector selects the workloads of a resource that the bindings of the policy are projected into
+type BindingPolicyWorkloadSelector struct {
+	// API version of the workload resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the workload resource.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Selector is a query that selects the workloads in the namespace of the policy
+	Selector metav1.LabelSelector `json:"selector"`
+	// Containers describes which containers in a Pod should be bound to
+	Containers []string `json:"containers,omitempty"`
+}
+
+// BindingPolicyBinding defines a binding projected into each selected workload
+type BindingPolicyBinding struct {
+	// Name is the name of the binding, used as the name of the binding directory within the workload. Must be unique
+	// within the policy.
+	Name string `json:"name"`
+	// Type is the type of the service as projected into the workload container
+	Type string `json:"type,omitempty"`
+	// Provider is the provider of the service as projected into the workload container
+	Provider string `json:"provider,omitempty"`
+	// Service is a reference to an object that fulfills the ProvisionedService duck type
+	Service ServiceBindingServiceReference `json:"service"`
+	// Env is the collection of mappings from Secret entries to environment variables
+	Env []EnvMapping `json:"env,omitempty"`
+}
+
+// BindingPolicySpec defines the desired state of BindingPolicy
+type BindingPolicySpec struct {
+	// Workloads is the collection of workload selectors the bindings are projected into
+	Workloads []BindingPolicyWorkloadSelector `json:"workloads,omitempty"`
+	// Bindings is the collection of bindings projected into each selected workload
+	Bindings []BindingPolicyBinding `json:"bindings,omitempty"`
+}
+
+// BindingPolicyStatus defines the observed state of BindingPolicy
+type BindingPolicyStatus struct {
+	// ObservedGeneration is the 'Generation' of the BindingPolicy that
+	// was last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions are the conditions of this BindingPolicy
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ServiceBindings reports the ServiceBindings generated for each binding and workload selector
+	ServiceBindings []BindingPolicyServiceBindingStatus `json:"serviceBindings,omitempty"`
+
+	// Workloads reports the aggregate state of the bindings for each selected workload
+	Workloads []BindingPolicyWorkloadStatus `json:"workloads,omitempty"`
+}
+
+// BindingPolicyServiceBindingStatus defines the observed state of a generated ServiceBinding
+type BindingPolicyServiceBindingStatus struct {
+	// Name of the generated ServiceBinding
+	Name string `json:"name"`
+	// Binding is the name of the binding in the policy
+	Binding string `json:"binding"`
+	// Ready is the status of the Ready condition of the ServiceBinding
+	Ready metav1.ConditionStatus `json:"ready,omitempty"`
+	// Reason is the reason of the Ready condition of the ServiceBinding
+	Reason string `json:"reason,omitempty"`
+}
+
+// BindingPolicyWorkloadStatus defines the aggregate state of the bindings for a selected workload
+type BindingPolicyWorkloadStatus struct {
+	// API version of the workload.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the workload.
+	Kind string `json:"kind"`
+	// Name of the workload.
+	Name string `json:"name"`
+	// Ready is True when every binding for the workload is ready, False when any binding failed, otherwise Unknown
+	Ready metav1.ConditionStatus `json:"ready"`
+	// NotReadyBindings are the names of the bindings for the workload that are not ready
+	NotReadyBindings []string `json:"notReadyBindings,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BindingPolicy is the Schema for the bindingpolicies API. The policy projects each of its bindings into every
+// workload in the namespace matched by a workload selector. A ServiceBinding, owned by the policy, is generated for
+// each binding and workload selector.
+type BindingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BindingPolicySpec   `json:"spec,omitempty"`
+	Status BindingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BindingPolicyList contains a list of BindingPolicy
+type BindingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BindingPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BindingPolicy{}, &BindingPolicyList{})
+}
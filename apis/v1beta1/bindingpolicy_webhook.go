@@ -0,0 +1,141 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func (r *BindingPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(NewBindingPolicyDefaulter()).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-servicebinding-io-v1beta1-bindingpolicy,mutating=true,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=bindingpolicies,verbs=create;update,versions=v1beta1,name=mbindingpolicy.kb.io,admissionReviewVersions={v1,v1beta1}
+
+// NewBindingPolicyDefaulter records the user making the request as the author of the BindingPolicy. The author is
+// recorded when the BindingPolicy is created or its spec is changed. The ServiceBindings generated for the policy are
+// authored by the controller, the author of the policy is checked in addition to the author of each ServiceBinding.
+func NewBindingPolicyDefaulter() admission.CustomDefaulter {
+	return &bindingPolicyDefaulter{}
+}
+
+// +kubebuilder:object:generate=false
+type bindingPolicyDefaulter struct{}
+
+// Default implements admission.CustomDefaulter
+func (d *bindingPolicyDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	r := obj.(*BindingPolicy)
+
+	return recordAuthor(ctx, &r.ObjectMeta, func(raw []byte) (*metav1.ObjectMeta, bool, error) {
+		old := &BindingPolicy{}
+		if err := json.Unmarshal(raw, old); err != nil {
+			return nil, false, err
+		}
+		return &old.ObjectMeta, !equality.Semantic.DeepEqual(old.Spec, r.Spec), nil
+	})
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-bindingpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=bindingpolicies,verbs=create;update,versions=v1beta1,name=vbindingpolicy.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &BindingPolicy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingPolicy) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingPolicy) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingPolicy) ValidateDelete() error {
+	return nil
+}
+
+func (r *BindingPolicy) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *BindingPolicySpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for i := range r.Workloads {
+		errs = append(errs, r.Workloads[i].validate(fldPath.Child("workloads").Index(i))...)
+	}
+	names := map[string]int{}
+	for i := range r.Bindings {
+		// check for duplicate names
+		if p, ok := names[r.Bindings[i].Name]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child("bindings", fmt.Sprintf("[%d, %d]", p, i), "name"), r.Bindings[i].Name))
+		}
+		names[r.Bindings[i].Name] = i
+		errs = append(errs, r.Bindings[i].validate(fldPath.Child("bindings").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *BindingPolicyWorkloadSelector) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.APIVersion == "" {
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), ""))
+	}
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&r.Selector); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("selector"), r.Selector, err.Error()))
+	}
+
+	return errs
+}
+
+func (r *BindingPolicyBinding) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		// the name is part of the name of the generated ServiceBinding
+		for _, msg := range validation.IsDNS1123Label(r.Name) {
+			errs = append(errs, field.Invalid(fldPath.Child("name"), r.Name, msg))
+		}
+	}
+	errs = append(errs, r.Service.validate(fldPath.Child("service"))...)
+	for i := range r.Env {
+		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
+	}
+
+	return errs
+}
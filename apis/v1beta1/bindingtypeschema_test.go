@@ -0,0 +1,101 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestBindingTypeSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *BindingTypeSchema
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &BindingTypeSchema{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "required and optional keys",
+			seed: &BindingTypeSchema{
+				Spec: BindingTypeSchemaSpec{
+					RequiredKeys: []string{"host", "port", "database"},
+					OptionalKeys: []string{"username", "password", "sslmode"},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "empty key is invalid",
+			seed: &BindingTypeSchema{
+				Spec: BindingTypeSchemaSpec{
+					RequiredKeys: []string{""},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "requiredKeys").Index(0), ""),
+			},
+		},
+		{
+			name: "invalid key",
+			seed: &BindingTypeSchema{
+				Spec: BindingTypeSchemaSpec{
+					OptionalKeys: []string{"not/a/key"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "optionalKeys").Index(0), "not/a/key", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+			},
+		},
+		{
+			name: "key both required and optional is invalid",
+			seed: &BindingTypeSchema{
+				Spec: BindingTypeSchemaSpec{
+					RequiredKeys: []string{"host"},
+					OptionalKeys: []string{"host"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "optionalKeys").Index(0), "host is also declared by spec.requiredKeys[0]"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
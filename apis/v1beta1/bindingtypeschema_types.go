@@ -0,0 +1,58 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingTypeSchemaSpec defines the entries expected in a binding of the type
+type BindingTypeSchemaSpec struct {
+	// RequiredKeys are the entries that must be present in a binding of the type, like `host` or `port`.
+	RequiredKeys []string `json:"requiredKeys,omitempty"`
+	// OptionalKeys are the entries that may be present in a binding of the type. Entries that are neither required nor
+	// optional are permitted, the optional keys document the well-known entries of the type.
+	OptionalKeys []string `json:"optionalKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BindingTypeSchema is the Schema for the bindingtypeschemas API. The schema declares the entries expected in a
+// binding secret of a type. The name of the schema is the value of the `type` entry it describes, like `postgresql`.
+type BindingTypeSchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BindingTypeSchemaSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BindingTypeSchemaList contains a list of BindingTypeSchema
+type BindingTypeSchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BindingTypeSchema `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BindingTypeSchema{}, &BindingTypeSchemaList{})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *BindingTypeSchema) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-bindingtypeschema,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=bindingtypeschemas,verbs=create;update,versions=v1beta1,name=vbindingtypeschema.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &BindingTypeSchema{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingTypeSchema) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingTypeSchema) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *BindingTypeSchema) ValidateDelete() error {
+	return nil
+}
+
+func (r *BindingTypeSchema) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *BindingTypeSchemaSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	keys := map[string]*field.Path{}
+	validateKeys := func(values []string, fldPath *field.Path) {
+		for i, key := range values {
+			keyPath := fldPath.Index(i)
+			if key == "" {
+				errs = append(errs, field.Required(keyPath, ""))
+				continue
+			}
+			for _, msg := range validation.IsConfigMapKey(key) {
+				errs = append(errs, field.Invalid(keyPath, key, msg))
+			}
+			// check for keys declared more than once
+			if p, ok := keys[key]; ok {
+				errs = append(errs, field.Duplicate(keyPath, fmt.Sprintf("%s is also declared by %s", key, p)))
+			}
+			keys[key] = keyPath
+		}
+	}
+	validateKeys(r.RequiredKeys, fldPath.Child("requiredKeys"))
+	validateKeys(r.OptionalKeys, fldPath.Child("optionalKeys"))
+
+	return errs
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestClusterServiceResourceMappingValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterServiceResourceMapping
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &ClusterServiceResourceMapping{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "secret name",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".spec.writeConnectionSecretToRef.name",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "data",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Data: []ClusterServiceResourceMappingData{
+								{Key: "type", Value: "mysql"},
+								{Key: "host", Value: "{{ .status.host }}"},
+								{Key: "uri", Value: "mysql://{{ .status.host }}:{{ .status.port }}"},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "missing secret name and data is invalid",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec.versions[0]"), "one of secretName or data is required"),
+			},
+		},
+		{
+			name: "secret name with data is invalid",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".spec.writeConnectionSecretToRef.name",
+							Data: []ClusterServiceResourceMappingData{
+								{Key: "type", Value: "mysql"},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.versions[0].data"), "may not be set with secretName"),
+			},
+		},
+		{
+			name: "invalid secret name",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "*",
+							SecretName: ".spec.refs[0]",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].secretName"), ".spec.refs[0]", "expression must end with a field"),
+			},
+		},
+		{
+			name: "invalid data",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version: "*",
+							Data: []ClusterServiceResourceMappingData{
+								{Key: "host", Value: "{{ .status.host"},
+								{Key: "host", Value: "localhost"},
+								{Key: "bad/key", Value: ""},
+								{Value: ""},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].data[0].value"), "{{ .status.host", "template: host:1: unclosed action"),
+				field.Duplicate(field.NewPath("spec.versions[0].data.[0, 1].key"), "host"),
+				field.Invalid(field.NewPath("spec.versions[0].data[2].key"), "bad/key", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+				field.Required(field.NewPath("spec.versions[0].data[3].key"), ""),
+			},
+		},
+		{
+			name: "overlapping versions are invalid",
+			seed: &ClusterServiceResourceMapping{
+				Spec: ClusterServiceResourceMappingSpec{
+					Versions: []ClusterServiceResourceMappingTemplate{
+						{
+							Version:    "v1*",
+							SecretName: ".spec.secretName",
+						},
+						{
+							Version:    "v1beta?",
+							SecretName: ".spec.secretName",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions.[0, 1].version"), "v1beta?", `overlaps with version "v1*", the mapping to use is ambiguous`),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
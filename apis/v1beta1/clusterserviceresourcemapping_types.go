@@ -0,0 +1,80 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterServiceResourceMappingTemplate defines how the binding Secret is resolved for a specific version of a service
+// resource.
+type ClusterServiceResourceMappingTemplate struct {
+	// Version is the version of the service resource that this mapping is for. Versions are matched with the same
+	// patterns and precedence as a ClusterWorkloadResourceMapping.
+	Version string `json:"version"`
+	// SecretName is a Restricted JSONPath that references the name of a Secret in the namespace of the service that
+	// contains the binding, like `.spec.writeConnectionSecretToRef.name`. May not be set with data.
+	SecretName string `json:"secretName,omitempty"`
+	// Data are the entries of a binding Secret synthesized from the fields of the service. The synthesized Secret is
+	// owned by the ServiceBinding. May not be set with secretName.
+	Data []ClusterServiceResourceMappingData `json:"data,omitempty"`
+}
+
+// ClusterServiceResourceMappingData defines an entry of a synthesized binding Secret
+type ClusterServiceResourceMappingData struct {
+	// Key is the name of the entry in the binding Secret.
+	Key string `json:"key"`
+	// Value is a Go text/template evaluated against the service resource, like `{{ .status.host }}`. Referencing a
+	// field that does not exist in the service, rendering more than 1MiB or executing for more than a second is an
+	// error.
+	Value string `json:"value"`
+}
+
+// ClusterServiceResourceMappingSpec defines the desired state of ClusterServiceResourceMapping
+type ClusterServiceResourceMappingSpec struct {
+	// Versions is the collection of versions for a given resource, with mappings.
+	Versions []ClusterServiceResourceMappingTemplate `json:"versions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterServiceResourceMapping is the Schema for the clusterserviceresourcemappings API. The mapping describes where
+// the binding Secret is found for a service resource that does not implement the Provisioned Service duck type. The
+// name of the mapping is the `{resource}.{group}` of the service resource.
+type ClusterServiceResourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterServiceResourceMappingSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterServiceResourceMappingList contains a list of ClusterServiceResourceMapping
+type ClusterServiceResourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterServiceResourceMapping `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterServiceResourceMapping{}, &ClusterServiceResourceMappingList{})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ClusterServiceResourceMapping) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-clusterserviceresourcemapping,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=clusterserviceresourcemappings,verbs=create;update,versions=v1beta1,name=vclusterserviceresourcemapping.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ClusterServiceResourceMapping{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterServiceResourceMapping) ValidateDelete() error {
+	return nil
+}
+
+func (r *ClusterServiceResourceMapping) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *ClusterServiceResourceMappingSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	versions := make([]string, len(r.Versions))
+	for i := range r.Versions {
+		versions[i] = r.Versions[i].Version
+	}
+	errs = append(errs, validateVersionPatterns(versions, fldPath.Child("versions"))...)
+	for i := range r.Versions {
+		errs = append(errs, r.Versions[i].validate(fldPath.Child("versions").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingTemplate) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	switch {
+	case r.SecretName != "" && len(r.Data) != 0:
+		errs = append(errs, field.Forbidden(fldPath.Child("data"), "may not be set with secretName"))
+	case r.SecretName != "":
+		errs = append(errs, validateRestrictedJsonPath(r.SecretName, fldPath.Child("secretName"))...)
+	case len(r.Data) != 0:
+		keys := map[string]int{}
+		for i := range r.Data {
+			// check for duplicate keys
+			if p, ok := keys[r.Data[i].Key]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("data", fmt.Sprintf("[%d, %d]", p, i), "key"), r.Data[i].Key))
+			}
+			keys[r.Data[i].Key] = i
+			errs = append(errs, r.Data[i].validate(fldPath.Child("data").Index(i))...)
+		}
+	default:
+		errs = append(errs, field.Required(fldPath, "one of secretName or data is required"))
+	}
+
+	return errs
+}
+
+func (r *ClusterServiceResourceMappingData) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Key == "" {
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	} else {
+		for _, msg := range validation.IsConfigMapKey(r.Key) {
+			errs = append(errs, field.Invalid(fldPath.Child("key"), r.Key, msg))
+		}
+	}
+	if _, err := template.New(r.Key).Parse(r.Value); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("value"), r.Value, err.Error()))
+	}
+
+	return errs
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/vmware-labs/reconciler-runtime/apis"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// These are valid conditions of ClusterWorkloadResourceMapping.
+const (
+	// ClusterWorkloadResourceMappingConditionReady means the mapping resolves against the workload resource it is
+	// named for.
+	ClusterWorkloadResourceMappingConditionReady = apis.ConditionReady
+	// ClusterWorkloadResourceMappingConditionResourceResolved means the name of the mapping is a `{resource}.{group}`
+	// known to the cluster.
+	ClusterWorkloadResourceMappingConditionResourceResolved = "ResourceResolved"
+	// ClusterWorkloadResourceMappingConditionVersionsServed means each mapped version is served for the workload
+	// resource.
+	ClusterWorkloadResourceMappingConditionVersionsServed = "VersionsServed"
+	// ClusterWorkloadResourceMappingConditionPathsResolved means each path of the mapping exists in the schema of the
+	// workload resource, when the schema is known.
+	ClusterWorkloadResourceMappingConditionPathsResolved = "PathsResolved"
+)
+
+var clusterworkloadresourcemappingCondSet = apis.NewLivingConditionSetWithHappyReason(
+	"Resolved",
+	ClusterWorkloadResourceMappingConditionResourceResolved,
+	ClusterWorkloadResourceMappingConditionVersionsServed,
+	ClusterWorkloadResourceMappingConditionPathsResolved,
+)
+
+func (s *ClusterWorkloadResourceMapping) GetConditionsAccessor() apis.ConditionsAccessor {
+	return &s.Status
+}
+
+func (s *ClusterWorkloadResourceMapping) GetConditionSet() apis.ConditionSet {
+	return clusterworkloadresourcemappingCondSet
+}
+
+func (s *ClusterWorkloadResourceMapping) GetConditionManager() apis.ConditionManager {
+	return clusterworkloadresourcemappingCondSet.Manage(&s.Status)
+}
+
+func (s *ClusterWorkloadResourceMappingStatus) InitializeConditions() {
+	conditionManager := clusterworkloadresourcemappingCondSet.Manage(s)
+	conditionManager.InitializeConditions()
+	// reset existing managed conditions
+	conditionManager.MarkUnknown(ClusterWorkloadResourceMappingConditionResourceResolved, "Initializing", "")
+	conditionManager.MarkUnknown(ClusterWorkloadResourceMappingConditionVersionsServed, "Initializing", "")
+	conditionManager.MarkUnknown(ClusterWorkloadResourceMappingConditionPathsResolved, "Initializing", "")
+}
+
+var _ apis.ConditionsAccessor = (*ClusterWorkloadResourceMappingStatus)(nil)
+
+// GetConditions implements ConditionsAccessor
+func (s *ClusterWorkloadResourceMappingStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+// SetConditions implements ConditionsAccessor
+func (s *ClusterWorkloadResourceMappingStatus) SetConditions(c []metav1.Condition) {
+	s.Conditions = c
+}
+
+// GetCondition fetches the condition of the specified type.
+func (s *ClusterWorkloadResourceMappingStatus) GetCondition(t string) *metav1.Condition {
+	for _, cond := range s.Conditions {
+		if cond.Type == t {
+			return &cond
+		}
+	}
+	return nil
+}
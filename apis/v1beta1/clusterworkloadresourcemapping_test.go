@@ -17,10 +17,18 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
@@ -46,21 +54,25 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 						{
 							Version:     "*",
 							Annotations: ".spec.template.metadata.annotations",
+							Labels:      ".spec.template.metadata.labels",
 							Containers: []ClusterWorkloadResourceMappingContainer{
 								{
 									Path:         ".spec.template.spec.initContainers[*]",
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 								{
 									Path:         ".spec.template.spec.containers[*]",
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 							},
-							Volumes: ".spec.template.spec.volumes",
+							Volumes:          ".spec.template.spec.volumes",
+							ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
 						},
 					},
 				},
@@ -101,12 +113,14 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 								{
 									Path:         ".spec.jobTemplate.spec.template.spec.containers[*]",
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 							},
 							Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
@@ -152,18 +166,21 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 									Path:         ".spec",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 								{
 									Path:         ".spec.initContainers[*]",
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 								{
 									Path:         ".spec.sidecarContainers[*]",
 									Name:         ".name",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 							},
 							Volumes: ".spec.volumes",
@@ -185,9 +202,12 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 									Path:         ".containers[*]",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
 								},
 							},
-							Volumes: ".volumes",
+							Volumes:          ".volumes",
+							Labels:           ".labels",
+							ImagePullSecrets: ".imagePullSecrets",
 						},
 					},
 				},
@@ -203,9 +223,84 @@ func TestClusterWorkloadResourceMappingDefault(t *testing.T) {
 									Path:         ".containers[*]",
 									Env:          ".env",
 									VolumeMounts: ".volumeMounts",
+									EnvFrom:      ".envFrom",
+								},
+							},
+							Volumes:          ".volumes",
+							Labels:           ".labels",
+							ImagePullSecrets: ".imagePullSecrets",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pod templates",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name:        "driver",
+									Annotations: ".spec.driver.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver",
+										},
+									},
+									Volumes: ".spec.driver.volumes",
+								},
+								{
+									Name:        "executor",
+									Annotations: ".spec.executor.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.executor",
+											Env:  ".environment",
+										},
+									},
+									Volumes: ".spec.executor.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name:        "driver",
+									Annotations: ".spec.driver.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path:         ".spec.driver",
+											Env:          ".env",
+											VolumeMounts: ".volumeMounts",
+											EnvFrom:      ".envFrom",
+										},
+									},
+									Volumes: ".spec.driver.volumes",
+								},
+								{
+									Name:        "executor",
+									Annotations: ".spec.executor.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path:         ".spec.executor",
+											Env:          ".environment",
+											VolumeMounts: ".volumeMounts",
+											EnvFrom:      ".envFrom",
+										},
+									},
+									Volumes: ".spec.executor.volumes",
 								},
 							},
-							Volumes: ".volumes",
 						},
 					},
 				},
@@ -280,6 +375,116 @@ func TestClusterWorkloadResourceMappingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec.versions[0].version"), ""),
 			},
 		},
+		{
+			name: "version patterns of different kinds are valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "v1",
+						},
+						{
+							Version: "v1beta*",
+						},
+						{
+							Version: ">=v1beta1 <v1",
+						},
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "disjoint version patterns of the same kind are valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: ">=v1",
+						},
+						{
+							Version: ">=v1beta1 <v1",
+						},
+						{
+							Version: "v1alpha?",
+						},
+						{
+							Version: "v1beta?",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid version patterns are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: ">=1.0",
+						},
+						{
+							Version: "~v1",
+						},
+						{
+							Version: "v1.*",
+						},
+						{
+							Version: "<v1 <v2",
+						},
+						{
+							Version: ">v1 <v1",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].version"), ">=1.0", `range constraint ">=1.0" must compare a Kubernetes version, like v1 or v1beta1`),
+				field.Invalid(field.NewPath("spec.versions[2].version"), "v1.*", "glob may only contain lowercase alphanumeric characters, '*' and '?'"),
+				field.Invalid(field.NewPath("spec.versions[3].version"), "<v1 <v2", "range may only have one upper bound"),
+				field.Invalid(field.NewPath("spec.versions[4].version"), ">v1 <v1", "range does not match any version"),
+			},
+		},
+		{
+			name: "overlapping ranges are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: ">=v1beta1",
+						},
+						{
+							Version: "<=v1beta1",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions.[0, 1].version"), "<=v1beta1", `overlaps with version ">=v1beta1", the mapping to use is ambiguous`),
+			},
+		},
+		{
+			name: "overlapping globs are invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "v1beta*",
+						},
+						{
+							Version: "v?beta1",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions.[0, 1].version"), "v?beta1", `overlaps with version "v1beta*", the mapping to use is ambiguous`),
+			},
+		},
 		{
 			name: "allow container path to use unrestricted synax",
 			seed: &ClusterWorkloadResourceMapping{
@@ -410,6 +615,190 @@ func TestClusterWorkloadResourceMappingValidate(t *testing.T) {
 				field.Invalid(field.NewPath("spec.versions[0].volumes"), "..", "unsupported node: NodeRecursive"),
 			},
 		},
+		{
+			name: "invalid labels",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Labels:  "..",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].labels"), "..", "unsupported node: NodeRecursive"),
+			},
+		},
+		{
+			name: "invalid imagePullSecrets",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:          "*",
+							ImagePullSecrets: "..",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].imagePullSecrets"), "..", "unsupported node: NodeRecursive"),
+			},
+		},
+		{
+			name: "invalid container envFrom",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									EnvFrom: "..",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].containers[0].envFrom"), "..", "unsupported node: NodeRecursive"),
+			},
+		},
+		{
+			name: "pod templates are valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name:        "driver",
+									Annotations: ".spec.driver.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver",
+										},
+									},
+									Volumes: ".spec.driver.volumes",
+								},
+								{
+									Name:        "executor",
+									Annotations: ".spec.executor.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.executor",
+										},
+									},
+									Volumes: ".spec.executor.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "pod templates with version paths is invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "*",
+							Annotations: ".annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path: ".containers[*]",
+								},
+							},
+							Volumes: ".volumes",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name:        "driver",
+									Annotations: ".spec.driver.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver",
+										},
+									},
+									Volumes: ".spec.driver.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.versions[0].annotations"), "may not be set with podTemplates"),
+				field.Forbidden(field.NewPath("spec.versions[0].containers"), "may not be set with podTemplates"),
+				field.Forbidden(field.NewPath("spec.versions[0].volumes"), "may not be set with podTemplates"),
+			},
+		},
+		{
+			name: "duplicate pod template name is invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name:        "driver",
+									Annotations: ".spec.driver.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.driver",
+										},
+									},
+									Volumes: ".spec.driver.volumes",
+								},
+								{
+									Name:        "driver",
+									Annotations: ".spec.executor.annotations",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{
+											Path: ".spec.executor",
+										},
+									},
+									Volumes: ".spec.executor.volumes",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec.versions[0].podTemplates.[0, 1].name"), "driver"),
+			},
+		},
+		{
+			name: "incomplete pod template is invalid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Annotations: "..",
+									Volumes:     "..",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec.versions[0].podTemplates[0].name"), ""),
+				field.Invalid(field.NewPath("spec.versions[0].podTemplates[0].annotations"), "..", "unsupported node: NodeRecursive"),
+				field.Invalid(field.NewPath("spec.versions[0].podTemplates[0].volumes"), "..", "unsupported node: NodeRecursive"),
+				field.Required(field.NewPath("spec.versions[0].podTemplates[0].containers"), ""),
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -432,6 +821,216 @@ func TestClusterWorkloadResourceMappingValidate(t *testing.T) {
 	}
 }
 
+func TestClusterWorkloadResourceMappingWarnings(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	tests := []struct {
+		name     string
+		seed     *ClusterWorkloadResourceMapping
+		mapper   meta.RESTMapper
+		expected []string
+	}{
+		{
+			name: "valid",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps"},
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "*"},
+						{Version: "v1"},
+					},
+				},
+			},
+			mapper:   mapper,
+			expected: []string{},
+		},
+		{
+			name: "no versions",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps"},
+			},
+			mapper: mapper,
+			expected: []string{
+				"no versions are mapped, the mapping will not be used",
+			},
+		},
+		{
+			name: "inexact resource",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment.app"},
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "*"},
+					},
+				},
+			},
+			mapper: mapper,
+			expected: []string{
+				"name \"deployment.app\" does not exactly match a known `{resource}.{group}`, did you mean \"deployments.apps\"?",
+			},
+		},
+		{
+			name: "unknown resource",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "widgets.example.com"},
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "*"},
+					},
+				},
+			},
+			mapper: mapper,
+			expected: []string{
+				"name \"widgets.example.com\" does not match a known `{resource}.{group}`",
+			},
+		},
+		{
+			name: "unserved version",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps"},
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "v1beta1"},
+					},
+				},
+			},
+			mapper: mapper,
+			expected: []string{
+				"version \"v1beta1\" is not served for \"deployments.apps\"",
+			},
+		},
+		{
+			name: "container collection",
+			seed: &ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps"},
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{Path: ".spec.template.spec.containers"},
+							},
+						},
+						{
+							Version: "v1",
+							PodTemplates: []ClusterWorkloadResourceMappingPodTemplate{
+								{
+									Name: "main",
+									Containers: []ClusterWorkloadResourceMappingContainer{
+										{Path: ".spec.main.spec.initContainers"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"container path \".spec.template.spec.containers\" selects the collection of containers rather than each container, did you mean \".spec.template.spec.containers[*]\"?",
+				"container path \".spec.main.spec.initContainers\" selects the collection of containers rather than each container, did you mean \".spec.main.spec.initContainers[*]\"?",
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			r := c.seed.DeepCopy()
+			if diff := cmp.Diff(c.expected, r.warnings(c.mapper)); diff != "" {
+				t.Errorf("warnings (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterWorkloadResourceMappingValidatorHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	validator := &clusterWorkloadResourceMappingValidator{
+		decoder: decoder,
+		mapper:  mapper,
+	}
+
+	request := func(operation admissionv1.Operation, r *ClusterWorkloadResourceMapping) admission.Request {
+		raw, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: operation,
+				Object:    runtime.RawExtension{Raw: raw},
+				OldObject: runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+	mapping := func(name, version string) *ClusterWorkloadResourceMapping {
+		return &ClusterWorkloadResourceMapping{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: GroupVersion.String(),
+				Kind:       "ClusterWorkloadResourceMapping",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: ClusterWorkloadResourceMappingSpec{
+				Versions: []ClusterWorkloadResourceMappingTemplate{
+					{Version: version},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		request          admission.Request
+		expectedAllowed  bool
+		expectedWarnings []string
+	}{
+		{
+			name:            "create",
+			request:         request(admissionv1.Create, mapping("deployments.apps", "*")),
+			expectedAllowed: true,
+		},
+		{
+			name:            "update",
+			request:         request(admissionv1.Update, mapping("deployments.apps", "*")),
+			expectedAllowed: true,
+		},
+		{
+			name:            "allowed with warnings",
+			request:         request(admissionv1.Create, mapping("widgets.example.com", "*")),
+			expectedAllowed: true,
+			expectedWarnings: []string{
+				"name \"widgets.example.com\" does not match a known `{resource}.{group}`",
+			},
+		},
+		{
+			name:            "denied",
+			request:         request(admissionv1.Create, mapping("deployments.apps", "")),
+			expectedAllowed: false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			resp := validator.Handle(context.TODO(), c.request)
+			if resp.Allowed != c.expectedAllowed {
+				t.Errorf("Handle() expected allowed %v, actual %v: %v", c.expectedAllowed, resp.Allowed, resp.Result)
+			}
+			if diff := cmp.Diff(c.expectedWarnings, resp.Warnings); diff != "" {
+				t.Errorf("Handle() warnings (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateJsonPath(t *testing.T) {
 	fldPath := field.NewPath("test")
 	tests := []struct {
@@ -444,6 +1043,35 @@ func TestValidateJsonPath(t *testing.T) {
 			expression: "..",
 			expected:   field.ErrorList{},
 		},
+		{
+			name:       "filter",
+			expression: `.spec.components[?(@.kind=="app")]`,
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "map values",
+			expression: ".spec.roles.*",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "union",
+			expression: ".spec['init','main'][*]",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "text",
+			expression: "'foo'",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, "'foo'", "unsupported node: NodeText: foo"),
+			},
+		},
+		{
+			name:       "identifier",
+			expression: "foo",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, "foo", "unsupported node: NodeIdentifier: foo"),
+			},
+		},
 		{
 			name:       "multiple root nodes",
 			expression: "}{",
@@ -525,17 +1153,46 @@ func TestValidateRestrictedJsonPath(t *testing.T) {
 			},
 		},
 		{
-			name:       "array",
+			name:       "array index",
+			expression: ".foo[0].bar",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "negative array index",
+			expression: ".foo[-1].bar",
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "array index not followed by field",
 			expression: "[0]",
 			expected: field.ErrorList{
-				field.Invalid(fldPath, "[0]", "unsupported node: NodeArray: [{0 true false} {1 true true} {0 false false}]"),
+				field.Invalid(fldPath, "[0]", "expression must end with a field"),
+			},
+		},
+		{
+			name:       "array slice",
+			expression: ".foo[0:2].bar",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, ".foo[0:2].bar", "unsupported node: NodeArray: [{0 true false} {2 true false} {0 false false}], only a single index is allowed"),
+			},
+		},
+		{
+			name:       "array wildcard",
+			expression: ".foo[*].bar",
+			expected: field.ErrorList{
+				field.Invalid(fldPath, ".foo[*].bar", "unsupported node: NodeArray: [{0 false false} {0 false false} {0 false false}], only a single index is allowed"),
 			},
 		},
 		{
 			name:       "filter",
+			expression: `.foo[?(@.kind=="app")].bar`,
+			expected:   field.ErrorList{},
+		},
+		{
+			name:       "filter not followed by field",
 			expression: "[?(@.foo)]",
 			expected: field.ErrorList{
-				field.Invalid(fldPath, "[?(@.foo)]", "unsupported node: NodeFilter: NodeList exists NodeList"),
+				field.Invalid(fldPath, "[?(@.foo)]", "expression must end with a field"),
 			},
 		},
 		{
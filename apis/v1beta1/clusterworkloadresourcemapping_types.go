@@ -21,11 +21,17 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // ClusterWorkloadResourceMappingTemplate defines the mapping for a specific version of an workload resource to a
 // logical PodTemplateSpec-like structure.
 type ClusterWorkloadResourceMappingTemplate struct {
-	// Version is the version of the workload resource that this mapping is for.
+	// Version is the version of the workload resource that this mapping is for. The version may be an exact version
+	// like `v1`, a range of Kubernetes versions ordered by priority like `>=v1beta1` or `>=v1alpha1 <v1`, a glob like
+	// `v1beta*` or `*` for all versions. When more than one version matches, an exact version is preferred over a
+	// range, a range over a glob and a glob over `*`. Ranges may not overlap other ranges, and globs other globs.
 	Version string `json:"version"`
 	// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These
 	// annotations must end up in the resulting Pod, and are generally not the workload resource's annotations.
 	// Defaults to `.spec.template.metadata.annotations`.
+	//
+	// Restricted JSONPaths are composed of fields, single array indices and filters, and must end with a field. A
+	// filter selects the first matching array element.
 	Annotations string `json:"annotations,omitempty"`
 	// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to
 	// mappings appropriate for a PodSpecable resource.
@@ -33,6 +39,40 @@ type ClusterWorkloadResourceMappingTemplate struct {
 	// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to
 	// `.spec.template.spec.volumes`.
 	Volumes string `json:"volumes,omitempty"`
+	// Labels is a Restricted JSONPath that references the labels map within the workload resource. These labels must
+	// end up in the resulting Pod, and are generally not the workload resource's labels. Defaults to
+	// `.spec.template.metadata.labels` when the annotations are also defaulted, otherwise labels are not projected.
+	Labels string `json:"labels,omitempty"`
+	// ImagePullSecrets is a Restricted JSONPath that references the slice of image pull secrets within the workload
+	// resource. Defaults to `.spec.template.spec.imagePullSecrets` when the volumes are also defaulted, otherwise
+	// image pull secrets are not projected.
+	ImagePullSecrets string `json:"imagePullSecrets,omitempty"`
+	// PodTemplates is the collection of mappings for workload resources that contain more than one pod template. Each
+	// pod template has its own annotations, containers and volumes, and is projected independently. When defined, the
+	// annotations, containers and volumes of the version must not be defined and are not defaulted.
+	PodTemplates []ClusterWorkloadResourceMappingPodTemplate `json:"podTemplates,omitempty"`
+}
+
+// ClusterWorkloadResourceMappingPodTemplate defines the mapping for a single pod template within a workload resource
+// that contains more than one pod template.
+type ClusterWorkloadResourceMappingPodTemplate struct {
+	// Name identifies the pod template within the workload resource. ServiceBindings may target specific pod templates
+	// by name.
+	Name string `json:"name"`
+	// Annotations is a Restricted JSONPath that references the annotations map for the pod template within the workload
+	// resource.
+	Annotations string `json:"annotations"`
+	// Containers is the collection of mappings to container-like fragments of the pod template.
+	Containers []ClusterWorkloadResourceMappingContainer `json:"containers"`
+	// Volumes is a Restricted JSONPath that references the slice of volumes for the pod template within the workload
+	// resource.
+	Volumes string `json:"volumes"`
+	// Labels is a Restricted JSONPath that references the labels map for the pod template within the workload resource.
+	// Labels are not projected if not defined.
+	Labels string `json:"labels,omitempty"`
+	// ImagePullSecrets is a Restricted JSONPath that references the slice of image pull secrets for the pod template
+	// within the workload resource. Image pull secrets are not projected if not defined.
+	ImagePullSecrets string `json:"imagePullSecrets,omitempty"`
 }
 
 // ClusterWorkloadResourceMappingContainer defines the mapping for a specific fragment of an workload resource
@@ -43,9 +83,11 @@ type ClusterWorkloadResourceMappingTemplate struct {
 // structures.
 type ClusterWorkloadResourceMappingContainer struct {
 	// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
+	// Array indices, slices, filters, wildcards and unions may be used. Entries of a map-valued collection are matched
+	// in key order.
 	Path string `json:"path"`
 	// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource
-	// fragment. If not defined, container name filtering is ignored.
+	// fragment. If not defined, the container is not bound when the ServiceBinding selects containers by name.
 	Name string `json:"name,omitempty"`
 	// Env is a Restricted JSONPath that references the slice of environment variables for the container with the
 	// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
@@ -55,6 +97,10 @@ type ClusterWorkloadResourceMappingContainer struct {
 	// container-like workload resource fragment. The referenced location is created if it does not exist. Defaults
 	// to `.volumeMounts`.
 	VolumeMounts string `json:"volumeMounts,omitempty"`
+	// EnvFrom is a Restricted JSONPath that references the slice of environment variable sources for the container
+	// with the container-like workload resource fragment. The referenced location is created if it does not exist.
+	// Defaults to `.envFrom`.
+	EnvFrom string `json:"envFrom,omitempty"`
 }
 
 // ClusterWorkloadResourceMappingSpec defines the desired state of ClusterWorkloadResourceMapping
@@ -63,9 +109,48 @@ type ClusterWorkloadResourceMappingSpec struct {
 	Versions []ClusterWorkloadResourceMappingTemplate `json:"versions,omitempty"`
 }
 
+// ClusterWorkloadResourceMappingStatus defines the observed state of ClusterWorkloadResourceMapping
+type ClusterWorkloadResourceMappingStatus struct {
+	// ObservedGeneration is the 'Generation' of the ClusterWorkloadResourceMapping that
+	// was last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions are the conditions of this ClusterWorkloadResourceMapping
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Versions reports how each mapped version resolves against the workload resource
+	Versions []ClusterWorkloadResourceMappingVersionStatus `json:"versions,omitempty"`
+
+	// ServiceBindings is the number of ServiceBindings with a workload of the mapped resource
+	ServiceBindings int32 `json:"serviceBindings,omitempty"`
+
+	// MissingVerbs are the verbs the controller is not permitted to use on the mapped resource. Workloads of the
+	// resource are not able to be bound until the permissions are granted to the controller.
+	MissingVerbs []string `json:"missingVerbs,omitempty"`
+}
+
+// ClusterWorkloadResourceMappingVersionStatus defines the observed state of a single mapped version
+type ClusterWorkloadResourceMappingVersionStatus struct {
+	// Version is the version of the workload resource, matching the mapped version.
+	Version string `json:"version"`
+	// Served is true when the version is served for the workload resource. The wildcard version is served when any
+	// version of the workload resource is served.
+	Served bool `json:"served"`
+	// PathsChecked is true when the paths of the mapping were checked against the schema of the workload resource.
+	// Only resources defined by a CustomResourceDefinition with a structural schema are checked.
+	PathsChecked bool `json:"pathsChecked,omitempty"`
+	// UnresolvedPaths are the paths of the mapping that do not exist in the schema of the workload resource. Values
+	// written to these locations are likely to be pruned.
+	UnresolvedPaths []string `json:"unresolvedPaths,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Bindings",type=integer,JSONPath=`.status.serviceBindings`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ClusterWorkloadResourceMapping is the Schema for the clusterworkloadresourcemappings API
@@ -73,7 +158,8 @@ type ClusterWorkloadResourceMapping struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ClusterWorkloadResourceMappingSpec `json:"spec,omitempty"`
+	Spec   ClusterWorkloadResourceMappingSpec   `json:"spec,omitempty"`
+	Status ClusterWorkloadResourceMappingStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
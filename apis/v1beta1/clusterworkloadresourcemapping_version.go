@@ -0,0 +1,307 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// versionPatternKind orders the kinds of version patterns by precedence, lower values take precedence
+type versionPatternKind int
+
+const (
+	versionPatternExact versionPatternKind = iota
+	versionPatternRange
+	versionPatternGlob
+	versionPatternWildcard
+)
+
+var (
+	kubeVersionRegexp = regexp.MustCompile(`^v[1-9][0-9]*((alpha|beta)[1-9][0-9]*)?$`)
+	globRegexp        = regexp.MustCompile(`^[a-z0-9*?]+$`)
+)
+
+// +kubebuilder:object:generate=false
+type versionPattern struct {
+	kind    versionPatternKind
+	pattern string
+	lower   *versionBound
+	upper   *versionBound
+}
+
+// +kubebuilder:object:generate=false
+type versionBound struct {
+	version   string
+	inclusive bool
+}
+
+// parseVersionPattern parses a version pattern. Patterns are one of:
+// - the wildcard `*`, matching every version
+// - a range of Kubernetes versions ordered by priority, like `>=v1beta1` or `>=v1alpha1 <v1`
+// - a glob where `*` matches any sequence of characters and `?` matches a single character, like `v1beta*`
+// - an exact version, like `v1`
+func parseVersionPattern(pattern string) (*versionPattern, error) {
+	switch {
+	case pattern == "*":
+		return &versionPattern{kind: versionPatternWildcard, pattern: pattern}, nil
+	case strings.HasPrefix(pattern, ">") || strings.HasPrefix(pattern, "<"):
+		return parseVersionRange(pattern)
+	case strings.ContainsAny(pattern, "*?"):
+		if !globRegexp.MatchString(pattern) {
+			return nil, fmt.Errorf("glob may only contain lowercase alphanumeric characters, '*' and '?'")
+		}
+		return &versionPattern{kind: versionPatternGlob, pattern: pattern}, nil
+	default:
+		return &versionPattern{kind: versionPatternExact, pattern: pattern}, nil
+	}
+}
+
+func parseVersionRange(pattern string) (*versionPattern, error) {
+	p := &versionPattern{kind: versionPatternRange, pattern: pattern}
+	constraints := strings.FieldsFunc(pattern, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	for _, constraint := range constraints {
+		op := strings.TrimRight(constraint, "abcdefghijklmnopqrstuvwxyz0123456789")
+		v := strings.TrimPrefix(constraint, op)
+		if !kubeVersionRegexp.MatchString(v) {
+			return nil, fmt.Errorf("range constraint %q must compare a Kubernetes version, like v1 or v1beta1", constraint)
+		}
+		switch op {
+		case ">", ">=":
+			if p.lower != nil {
+				return nil, fmt.Errorf("range may only have one lower bound")
+			}
+			p.lower = &versionBound{version: v, inclusive: op == ">="}
+		case "<", "<=":
+			if p.upper != nil {
+				return nil, fmt.Errorf("range may only have one upper bound")
+			}
+			p.upper = &versionBound{version: v, inclusive: op == "<="}
+		default:
+			return nil, fmt.Errorf("range constraint %q must start with one of '>', '>=', '<' or '<='", constraint)
+		}
+	}
+	if p.lower != nil && p.upper != nil && !boundsIntersect(p.lower, p.upper) {
+		return nil, fmt.Errorf("range does not match any version")
+	}
+	return p, nil
+}
+
+func (p *versionPattern) matches(v string) bool {
+	switch p.kind {
+	case versionPatternWildcard:
+		return true
+	case versionPatternExact:
+		return p.pattern == v
+	case versionPatternGlob:
+		matched, _ := path.Match(p.pattern, v)
+		return matched
+	case versionPatternRange:
+		if !kubeVersionRegexp.MatchString(v) {
+			return false
+		}
+		if p.lower != nil {
+			if c := version.CompareKubeAwareVersionStrings(v, p.lower.version); c < 0 || (c == 0 && !p.lower.inclusive) {
+				return false
+			}
+		}
+		if p.upper != nil {
+			if c := version.CompareKubeAwareVersionStrings(v, p.upper.version); c > 0 || (c == 0 && !p.upper.inclusive) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// overlaps returns true when a version could be matched by both patterns of the same kind, making the precedence
+// between them ambiguous
+func (p *versionPattern) overlaps(o *versionPattern) bool {
+	if p.kind != o.kind {
+		return false
+	}
+	switch p.kind {
+	case versionPatternExact, versionPatternWildcard:
+		return p.pattern == o.pattern
+	case versionPatternGlob:
+		return globsIntersect(p.pattern, o.pattern)
+	case versionPatternRange:
+		lower := p.lower
+		if lower == nil || (o.lower != nil && compareBounds(o.lower, lower) > 0) {
+			lower = o.lower
+		}
+		upper := p.upper
+		if upper == nil || (o.upper != nil && compareBounds(o.upper, upper) < 0) {
+			upper = o.upper
+		}
+		if lower == nil || upper == nil {
+			return true
+		}
+		return boundsIntersect(lower, upper)
+	}
+	return false
+}
+
+// compareBounds orders bounds by version priority. For equal versions, an exclusive bound is the more restrictive so
+// it is ordered higher for a lower bound and lower for an upper bound; the caller is only interested in the sign.
+func compareBounds(a, b *versionBound) int {
+	if c := version.CompareKubeAwareVersionStrings(a.version, b.version); c != 0 {
+		return c
+	}
+	if a.inclusive == b.inclusive {
+		return 0
+	}
+	if a.inclusive {
+		return -1
+	}
+	return 1
+}
+
+func boundsIntersect(lower, upper *versionBound) bool {
+	c := version.CompareKubeAwareVersionStrings(lower.version, upper.version)
+	return c < 0 || (c == 0 && lower.inclusive && upper.inclusive)
+}
+
+// globsIntersect returns true when at least one string is matched by both globs
+func globsIntersect(a, b string) bool {
+	memo := map[[2]int]bool{}
+	var intersect func(i, j int) bool
+	intersect = func(i, j int) bool {
+		key := [2]int{i, j}
+		if v, ok := memo[key]; ok {
+			return v
+		}
+		var result bool
+		switch {
+		case i == len(a) && j == len(b):
+			result = true
+		case i < len(a) && a[i] == '*':
+			result = intersect(i+1, j) || (j < len(b) && intersect(i, j+1))
+		case j < len(b) && b[j] == '*':
+			result = intersect(i, j+1) || (i < len(a) && intersect(i+1, j))
+		case i == len(a) || j == len(b):
+			result = false
+		default:
+			result = (a[i] == '?' || b[j] == '?' || a[i] == b[j]) && intersect(i+1, j+1)
+		}
+		memo[key] = result
+		return result
+	}
+	return intersect(0, 0)
+}
+
+// MatchesVersion returns true when the version pattern of the template matches the version of a workload resource.
+// Invalid patterns never match.
+func (r *ClusterWorkloadResourceMappingTemplate) MatchesVersion(v string) bool {
+	p, err := parseVersionPattern(r.Version)
+	if err != nil {
+		return false
+	}
+	return p.matches(v)
+}
+
+// LookupVersion returns the template whose version pattern applies to the version of a workload resource, or nil if
+// no template matches. An exact version takes precedence over a range, a range over a glob, and a glob over the `*`
+// wildcard. Overlapping patterns of the same kind are rejected by validation, for mappings that predate validation the
+// first matching template is used.
+func (r *ClusterWorkloadResourceMappingSpec) LookupVersion(v string) *ClusterWorkloadResourceMappingTemplate {
+	patterns := make([]string, len(r.Versions))
+	for i := range r.Versions {
+		patterns[i] = r.Versions[i].Version
+	}
+	if i := lookupVersion(patterns, v); i >= 0 {
+		return &r.Versions[i]
+	}
+	return nil
+}
+
+// LookupVersion returns the template whose version pattern applies to the version of a service resource, or nil if
+// no template matches. Versions are matched with the same precedence as a ClusterWorkloadResourceMapping.
+func (r *ClusterServiceResourceMappingSpec) LookupVersion(v string) *ClusterServiceResourceMappingTemplate {
+	patterns := make([]string, len(r.Versions))
+	for i := range r.Versions {
+		patterns[i] = r.Versions[i].Version
+	}
+	if i := lookupVersion(patterns, v); i >= 0 {
+		return &r.Versions[i]
+	}
+	return nil
+}
+
+// lookupVersion returns the index of the most specific pattern that matches the version, or -1 if no pattern matches
+func lookupVersion(patterns []string, v string) int {
+	match := -1
+	var matchKind versionPatternKind
+	for i := range patterns {
+		p, err := parseVersionPattern(patterns[i])
+		if err != nil || !p.matches(v) {
+			continue
+		}
+		if match < 0 || p.kind < matchKind {
+			match = i
+			matchKind = p.kind
+		}
+	}
+	return match
+}
+
+// validateVersionPatterns checks that each version pattern is valid and unique, and that patterns of the same kind do
+// not overlap as the precedence between them would be ambiguous
+func validateVersionPatterns(patterns []string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	parsed := make([]*versionPattern, len(patterns))
+	seen := map[string]int{}
+	for i, pattern := range patterns {
+		if pattern == "" {
+			errs = append(errs, field.Required(fldPath.Index(i).Child("version"), ""))
+			continue
+		}
+		if p, ok := seen[pattern]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child(fmt.Sprintf("[%d, %d]", p, i), "version"), pattern))
+			continue
+		}
+		seen[pattern] = i
+		p, err := parseVersionPattern(pattern)
+		if err != nil {
+			errs = append(errs, field.Invalid(fldPath.Index(i).Child("version"), pattern, err.Error()))
+			continue
+		}
+		parsed[i] = p
+	}
+
+	for i := range parsed {
+		for p := 0; p < i; p++ {
+			if parsed[i] == nil || parsed[p] == nil {
+				continue
+			}
+			if parsed[i].overlaps(parsed[p]) {
+				errs = append(errs, field.Invalid(fldPath.Child(fmt.Sprintf("[%d, %d]", p, i), "version"), patterns[i], fmt.Sprintf("overlaps with version %q, the mapping to use is ambiguous", patterns[p])))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestClusterWorkloadResourceMappingTemplateMatchesVersion(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		version  string
+		expected bool
+	}{
+		{pattern: "*", version: "v1", expected: true},
+		{pattern: "v1", version: "v1", expected: true},
+		{pattern: "v1", version: "v1beta1", expected: false},
+		{pattern: "v1beta*", version: "v1beta3", expected: true},
+		{pattern: "v1beta*", version: "v1", expected: false},
+		{pattern: "v?", version: "v2", expected: true},
+		{pattern: ">=v1beta1", version: "v1beta1", expected: true},
+		{pattern: ">=v1beta1", version: "v1beta3", expected: true},
+		{pattern: ">=v1beta1", version: "v1", expected: true},
+		{pattern: ">=v1beta1", version: "v1alpha1", expected: false},
+		{pattern: ">v1beta1", version: "v1beta1", expected: false},
+		{pattern: ">=v1alpha1 <v1", version: "v1beta3", expected: true},
+		{pattern: ">=v1alpha1, <v1", version: "v1", expected: false},
+		{pattern: "<=v1", version: "v1", expected: true},
+		{pattern: "<=v1", version: "v2", expected: false},
+		{pattern: ">=v1", version: "v1.2", expected: false},
+		{pattern: ">=1", version: "v1", expected: false},
+	}
+
+	for _, c := range tests {
+		t.Run(c.pattern+" "+c.version, func(t *testing.T) {
+			template := &ClusterWorkloadResourceMappingTemplate{Version: c.pattern}
+			if actual := template.MatchesVersion(c.version); actual != c.expected {
+				t.Errorf("MatchesVersion() expected %v, actual %v", c.expected, actual)
+			}
+		})
+	}
+}
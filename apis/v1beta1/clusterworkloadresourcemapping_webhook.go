@@ -17,16 +17,34 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/util/jsonpath"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func (r *ClusterWorkloadResourceMapping) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	decoder, err := admission.NewDecoder(mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	// the validating webhook is registered ahead of the builder so that admission warnings are returned
+	mgr.GetWebhookServer().Register("/validate-servicebinding-io-v1beta1-clusterworkloadresourcemapping", &webhook.Admission{
+		Handler: &clusterWorkloadResourceMappingValidator{
+			decoder: decoder,
+			mapper:  mgr.GetRESTMapper(),
+		},
+	})
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -36,15 +54,30 @@ var _ webhook.Defaulter = &ClusterWorkloadResourceMapping{}
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *ClusterWorkloadResourceMapping) Default() {
-	for i := range r.Spec.Versions {
-		r.Spec.Versions[i].Default()
+	r.Spec.Default()
+}
+
+// Default applies values to each mapped version
+func (r *ClusterWorkloadResourceMappingSpec) Default() {
+	for i := range r.Versions {
+		r.Versions[i].Default()
 	}
 }
 
 // Default applies values that are appropriate for a PodSpecable resource
 func (r *ClusterWorkloadResourceMappingTemplate) Default() {
+	if len(r.PodTemplates) != 0 {
+		// pod templates are not PodSpecable
+		for i := range r.PodTemplates {
+			r.PodTemplates[i].Default()
+		}
+		return
+	}
 	if r.Annotations == "" {
 		r.Annotations = ".spec.template.metadata.annotations"
+		if r.Labels == "" {
+			r.Labels = ".spec.template.metadata.labels"
+		}
 	}
 	if len(r.Containers) == 0 {
 		r.Containers = []ClusterWorkloadResourceMappingContainer{
@@ -59,16 +92,33 @@ func (r *ClusterWorkloadResourceMappingTemplate) Default() {
 		}
 	}
 	for i := range r.Containers {
-		c := &r.Containers[i]
-		if c.Env == "" {
-			c.Env = ".env"
-		}
-		if c.VolumeMounts == "" {
-			c.VolumeMounts = ".volumeMounts"
-		}
+		r.Containers[i].Default()
 	}
 	if r.Volumes == "" {
 		r.Volumes = ".spec.template.spec.volumes"
+		if r.ImagePullSecrets == "" {
+			r.ImagePullSecrets = ".spec.template.spec.imagePullSecrets"
+		}
+	}
+}
+
+// Default applies values to the containers of the pod template
+func (r *ClusterWorkloadResourceMappingPodTemplate) Default() {
+	for i := range r.Containers {
+		r.Containers[i].Default()
+	}
+}
+
+// Default applies values that are appropriate for a Container
+func (r *ClusterWorkloadResourceMappingContainer) Default() {
+	if r.Env == "" {
+		r.Env = ".env"
+	}
+	if r.VolumeMounts == "" {
+		r.VolumeMounts = ".volumeMounts"
+	}
+	if r.EnvFrom == "" {
+		r.EnvFrom = ".envFrom"
 	}
 }
 
@@ -94,17 +144,103 @@ func (r *ClusterWorkloadResourceMapping) ValidateDelete() error {
 	return nil
 }
 
+// +kubebuilder:object:generate=false
+type clusterWorkloadResourceMappingValidator struct {
+	decoder *admission.Decoder
+	mapper  meta.RESTMapper
+}
+
+// Handle validates the ClusterWorkloadResourceMapping, allowed requests include warnings for likely mistakes
+func (v *clusterWorkloadResourceMappingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	r := &ClusterWorkloadResourceMapping{}
+	if err := v.decoder.Decode(req, r); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var err error
+	switch req.Operation {
+	case admissionv1.Update:
+		old := &ClusterWorkloadResourceMapping{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		err = r.ValidateUpdate(old)
+	default:
+		err = r.ValidateCreate()
+	}
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("").WithWarnings(r.warnings(v.mapper)...)
+}
+
+// warnings returns likely mistakes that do not make the mapping invalid. When a mapper is provided, the name and
+// versions of the mapping are checked against the resources known to the cluster.
+func (r *ClusterWorkloadResourceMapping) warnings(mapper meta.RESTMapper) []string {
+	warnings := []string{}
+
+	if len(r.Spec.Versions) == 0 {
+		warnings = append(warnings, "no versions are mapped, the mapping will not be used")
+	}
+
+	if mapper != nil {
+		gr := schema.ParseGroupResource(r.Name)
+		gvrs, err := mapper.ResourcesFor(gr.WithVersion(""))
+		if err != nil || len(gvrs) == 0 {
+			warnings = append(warnings, fmt.Sprintf("name %q does not match a known `{resource}.{group}`", r.Name))
+		} else if canonical := gvrs[0].GroupResource(); canonical != gr {
+			// the mapper is lenient with singular resources and partial groups, mappings are looked up by exact name
+			warnings = append(warnings, fmt.Sprintf("name %q does not exactly match a known `{resource}.{group}`, did you mean %q?", r.Name, canonical.String()))
+		} else {
+			served := map[string]bool{}
+			for _, gvr := range gvrs {
+				served[gvr.Version] = true
+			}
+			for _, version := range r.Spec.Versions {
+				if version.Version == "*" {
+					continue
+				}
+				matched := false
+				for v := range served {
+					matched = matched || version.MatchesVersion(v)
+				}
+				if !matched {
+					warnings = append(warnings, fmt.Sprintf("version %q is not served for %q", version.Version, r.Name))
+				}
+			}
+		}
+	}
+
+	for _, version := range r.Spec.Versions {
+		containers := version.Containers
+		for _, podTemplate := range version.PodTemplates {
+			containers = append(containers, podTemplate.Containers...)
+		}
+		for _, container := range containers {
+			if strings.HasSuffix(strings.ToLower(container.Path), "containers") {
+				warnings = append(warnings, fmt.Sprintf("container path %q selects the collection of containers rather than each container, did you mean %q?", container.Path, container.Path+"[*]"))
+			}
+		}
+	}
+
+	return warnings
+}
+
 func (r *ClusterWorkloadResourceMapping) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *ClusterWorkloadResourceMappingSpec) validate(fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
-	versions := map[string]int{}
-	for i := range r.Spec.Versions {
-		// check for duplicate versions
-		if p, ok := versions[r.Spec.Versions[i].Version]; ok {
-			errs = append(errs, field.Duplicate(field.NewPath("spec", "versions", fmt.Sprintf("[%d, %d]", p, i), "version"), r.Spec.Versions[i].Version))
-		}
-		versions[r.Spec.Versions[i].Version] = i
-		errs = append(errs, r.Spec.Versions[i].validate(field.NewPath("spec", "versions").Index(i))...)
+	versions := make([]string, len(r.Versions))
+	for i := range r.Versions {
+		versions[i] = r.Versions[i].Version
+	}
+	errs = append(errs, validateVersionPatterns(versions, fldPath.Child("versions"))...)
+	for i := range r.Versions {
+		errs = append(errs, r.Versions[i].validate(fldPath.Child("versions").Index(i))...)
 	}
 
 	return errs
@@ -113,11 +249,57 @@ func (r *ClusterWorkloadResourceMapping) validate() field.ErrorList {
 func (r *ClusterWorkloadResourceMappingTemplate) validate(fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
-	if r.Version == "" {
-		errs = append(errs, field.Required(fldPath.Child("version"), ""))
+	if len(r.PodTemplates) != 0 {
+		if r.Annotations != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("annotations"), "may not be set with podTemplates"))
+		}
+		if len(r.Containers) != 0 {
+			errs = append(errs, field.Forbidden(fldPath.Child("containers"), "may not be set with podTemplates"))
+		}
+		if r.Volumes != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("volumes"), "may not be set with podTemplates"))
+		}
+		if r.Labels != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("labels"), "may not be set with podTemplates"))
+		}
+		if r.ImagePullSecrets != "" {
+			errs = append(errs, field.Forbidden(fldPath.Child("imagePullSecrets"), "may not be set with podTemplates"))
+		}
+		names := map[string]int{}
+		for i := range r.PodTemplates {
+			// check for duplicate names
+			if p, ok := names[r.PodTemplates[i].Name]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("podTemplates", fmt.Sprintf("[%d, %d]", p, i), "name"), r.PodTemplates[i].Name))
+			}
+			names[r.PodTemplates[i].Name] = i
+			errs = append(errs, r.PodTemplates[i].validate(fldPath.Child("podTemplates").Index(i))...)
+		}
+		return errs
+	}
+	errs = append(errs, validateRestrictedJsonPath(r.Annotations, fldPath.Child("annotations"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.Volumes, fldPath.Child("volumes"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.Labels, fldPath.Child("labels"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.ImagePullSecrets, fldPath.Child("imagePullSecrets"))...)
+	for i := range r.Containers {
+		errs = append(errs, r.Containers[i].validate(fldPath.Child("containers").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ClusterWorkloadResourceMappingPodTemplate) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
 	errs = append(errs, validateRestrictedJsonPath(r.Annotations, fldPath.Child("annotations"))...)
 	errs = append(errs, validateRestrictedJsonPath(r.Volumes, fldPath.Child("volumes"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.Labels, fldPath.Child("labels"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.ImagePullSecrets, fldPath.Child("imagePullSecrets"))...)
+	if len(r.Containers) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("containers"), ""))
+	}
 	for i := range r.Containers {
 		errs = append(errs, r.Containers[i].validate(fldPath.Child("containers").Index(i))...)
 	}
@@ -135,6 +317,7 @@ func (r *ClusterWorkloadResourceMappingContainer) validate(fldPath *field.Path)
 	}
 	errs = append(errs, validateRestrictedJsonPath(r.Env, fldPath.Child("env"))...)
 	errs = append(errs, validateRestrictedJsonPath(r.VolumeMounts, fldPath.Child("volumeMounts"))...)
+	errs = append(errs, validateRestrictedJsonPath(r.EnvFrom, fldPath.Child("envFrom"))...)
 
 	return errs
 }
@@ -148,6 +331,27 @@ func validateJsonPath(expression string, fldPath *field.Path) field.ErrorList {
 		if len(p.Root.Nodes) != 1 {
 			errs = append(errs, field.Invalid(fldPath, expression, "too many root nodes"))
 		}
+		// only allow nodes that select existing values
+		errs = append(errs, validateJsonPathNodes(expression, flattenJsonPath(p.Root), fldPath)...)
+	}
+
+	return errs
+}
+
+func validateJsonPathNodes(expression string, nodes []jsonpath.Node, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *jsonpath.FieldNode, *jsonpath.ArrayNode, *jsonpath.FilterNode, *jsonpath.WildcardNode, *jsonpath.RecursiveNode:
+			continue
+		case *jsonpath.UnionNode:
+			for _, list := range n.Nodes {
+				errs = append(errs, validateJsonPathNodes(expression, flattenJsonPath(list), fldPath)...)
+			}
+		default:
+			errs = append(errs, field.Invalid(fldPath, expression, fmt.Sprintf("unsupported node: %s", n)))
+		}
 	}
 
 	return errs
@@ -162,14 +366,23 @@ func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.Er
 		if len(p.Root.Nodes) != 1 {
 			errs = append(errs, field.Invalid(fldPath, expression, "too many root nodes"))
 		}
-		// only allow jsonpath.NodeField nodes
-		nodes := p.Root.Nodes
-		for i := 0; i < len(nodes); i++ {
-			switch n := nodes[i].(type) {
-			case *jsonpath.ListNode:
-				nodes = append(nodes, n.Nodes...)
+		// only allow nodes that resolve to a single writable location: fields, single array indices and filters
+		// selecting the first matching element. The location written must be a field.
+		nodes := flattenJsonPath(p.Root)
+		for i, node := range nodes {
+			switch n := node.(type) {
 			case *jsonpath.FieldNode:
 				continue
+			case *jsonpath.ArrayNode:
+				if !n.Params[0].Known || !n.Params[1].Derived {
+					errs = append(errs, field.Invalid(fldPath, expression, fmt.Sprintf("unsupported node: %s, only a single index is allowed", n)))
+				} else if i == len(nodes)-1 {
+					errs = append(errs, field.Invalid(fldPath, expression, "expression must end with a field"))
+				}
+			case *jsonpath.FilterNode:
+				if i == len(nodes)-1 {
+					errs = append(errs, field.Invalid(fldPath, expression, "expression must end with a field"))
+				}
 			default:
 				errs = append(errs, field.Invalid(fldPath, expression, fmt.Sprintf("unsupported node: %s", n)))
 			}
@@ -178,3 +391,14 @@ func validateRestrictedJsonPath(expression string, fldPath *field.Path) field.Er
 
 	return errs
 }
+
+func flattenJsonPath(node jsonpath.Node) []jsonpath.Node {
+	if list, ok := node.(*jsonpath.ListNode); ok {
+		nodes := []jsonpath.Node{}
+		for i := range list.Nodes {
+			nodes = append(nodes, flattenJsonPath(list.Nodes[i])...)
+		}
+		return nodes
+	}
+	return []jsonpath.Node{node}
+}
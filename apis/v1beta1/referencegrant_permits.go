@@ -0,0 +1,42 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Permits returns true when the grant permits ServiceBindings in the namespace to reference the service. A service
+// referenced by a selector, rather than by name, is only permitted when the grant permits every resource of the kind.
+func (r *ReferenceGrant) Permits(namespace string, gk schema.GroupKind, name string) bool {
+	from := false
+	for _, f := range r.Spec.From {
+		if f.Namespace == namespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+	for _, to := range r.Spec.To {
+		if to.Group == gk.Group && to.Kind == gk.Kind && (to.Name == "" || to.Name == name) {
+			return true
+		}
+	}
+	return false
+}
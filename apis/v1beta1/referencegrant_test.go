@@ -0,0 +1,188 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestReferenceGrantValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ReferenceGrant
+		expected field.ErrorList
+	}{
+		{
+			name: "empty is not valid",
+			seed: &ReferenceGrant{},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "from"), ""),
+				field.Required(field.NewPath("spec", "to"), ""),
+			},
+		},
+		{
+			name: "valid",
+			seed: &ReferenceGrant{
+				Spec: ReferenceGrantSpec{
+					From: []ReferenceGrantFrom{
+						{Namespace: "team-a"},
+					},
+					To: []ReferenceGrantTo{
+						{Group: "", Kind: "Secret", Name: "orders-db"},
+						{Group: "example.com", Kind: "MyService"},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid from",
+			seed: &ReferenceGrant{
+				Spec: ReferenceGrantSpec{
+					From: []ReferenceGrantFrom{
+						{},
+						{Namespace: "Team_A"},
+					},
+					To: []ReferenceGrantTo{
+						{Kind: "Secret"},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "from").Index(0).Child("namespace"), ""),
+				field.Invalid(field.NewPath("spec", "from").Index(1).Child("namespace"), "Team_A", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		{
+			name: "invalid to",
+			seed: &ReferenceGrant{
+				Spec: ReferenceGrantSpec{
+					From: []ReferenceGrantFrom{
+						{Namespace: "team-a"},
+					},
+					To: []ReferenceGrantTo{
+						{Name: "orders-db"},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "to").Index(0).Child("kind"), ""),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReferenceGrantPermits(t *testing.T) {
+	grant := &ReferenceGrant{
+		Spec: ReferenceGrantSpec{
+			From: []ReferenceGrantFrom{
+				{Namespace: "team-a"},
+			},
+			To: []ReferenceGrantTo{
+				{Group: "", Kind: "Secret", Name: "orders-db"},
+				{Group: "example.com", Kind: "MyService"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		gk        schema.GroupKind
+		service   string
+		expected  bool
+	}{
+		{
+			name:      "named resource",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Kind: "Secret"},
+			service:   "orders-db",
+			expected:  true,
+		},
+		{
+			name:      "other named resource",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Kind: "Secret"},
+			service:   "payments-db",
+			expected:  false,
+		},
+		{
+			name:      "selected resources of a named grant",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Kind: "Secret"},
+			expected:  false,
+		},
+		{
+			name:      "any resource of the kind",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Group: "example.com", Kind: "MyService"},
+			service:   "my-service",
+			expected:  true,
+		},
+		{
+			name:      "selected resources of the kind",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Group: "example.com", Kind: "MyService"},
+			expected:  true,
+		},
+		{
+			name:      "other group",
+			namespace: "team-a",
+			gk:        schema.GroupKind{Group: "example.org", Kind: "MyService"},
+			service:   "my-service",
+			expected:  false,
+		},
+		{
+			name:      "other namespace",
+			namespace: "team-b",
+			gk:        schema.GroupKind{Kind: "Secret"},
+			service:   "orders-db",
+			expected:  false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := grant.Permits(c.namespace, c.gk, c.service); actual != c.expected {
+				t.Errorf("Permits() = %v, expected %v", actual, c.expected)
+			}
+		})
+	}
+}
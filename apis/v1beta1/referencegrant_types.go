@@ -0,0 +1,74 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReferenceGrantFrom defines the namespace of the ServiceBindings that may reference the services
+type ReferenceGrantFrom struct {
+	// Namespace of the ServiceBindings
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo defines the services that may be referenced
+type ReferenceGrantTo struct {
+	// Group of the referent, empty for the core API group.
+	Group string `json:"group"`
+	// Kind of the referent.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Name of the referent. When empty, every resource of the kind in the namespace may be referenced, including by a
+	// selector.
+	Name string `json:"name,omitempty"`
+}
+
+// ReferenceGrantSpec defines the desired state of ReferenceGrant
+type ReferenceGrantSpec struct {
+	// From are the namespaces whose ServiceBindings may reference the services
+	From []ReferenceGrantFrom `json:"from"`
+	// To are the services in the namespace of the grant that may be referenced
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReferenceGrant is the Schema for the referencegrants API. A grant in the namespace of a service permits
+// ServiceBindings in other namespaces to reference the service. The binding secret of the service is copied into the
+// namespace of the ServiceBinding, revoking the grant removes the copy and unprojects the binding.
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReferenceGrantList contains a list of ReferenceGrant
+type ReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ReferenceGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReferenceGrant{}, &ReferenceGrantList{})
+}
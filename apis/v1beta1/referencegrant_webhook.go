@@ -0,0 +1,97 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ReferenceGrant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-referencegrant,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=referencegrants,verbs=create;update,versions=v1beta1,name=vreferencegrant.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ReferenceGrant{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ReferenceGrant) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ReferenceGrant) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ReferenceGrant) ValidateDelete() error {
+	return nil
+}
+
+func (r *ReferenceGrant) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *ReferenceGrantSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(r.From) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("from"), ""))
+	}
+	for i := range r.From {
+		errs = append(errs, r.From[i].validate(fldPath.Child("from").Index(i))...)
+	}
+	if len(r.To) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("to"), ""))
+	}
+	for i := range r.To {
+		errs = append(errs, r.To[i].validate(fldPath.Child("to").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ReferenceGrantFrom) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Namespace == "" {
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(r.Namespace) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, msg))
+		}
+	}
+
+	return errs
+}
+
+func (r *ReferenceGrantTo) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+
+	return errs
+}
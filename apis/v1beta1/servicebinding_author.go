@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ServiceBindingAuthorAnnotation records the user who created the ServiceBinding, or who last changed its spec, as a
+// JSON encoded UserInfo. The annotation is maintained by the mutating webhook for ServiceBindings, a value set by the
+// user is replaced. The author of a BindingPolicy is recorded by the same annotation.
+const ServiceBindingAuthorAnnotation = "servicebinding.io/author"
+
+// GetAuthor returns the recorded author of the ServiceBinding, nil when an author is not recorded
+func (r *ServiceBinding) GetAuthor() (*authenticationv1.UserInfo, error) {
+	return getAuthor(r.Annotations)
+}
+
+// SetAuthor records the author of the ServiceBinding, a nil author removes the recorded author
+func (r *ServiceBinding) SetAuthor(author *authenticationv1.UserInfo) {
+	setAuthor(&r.ObjectMeta, author)
+}
+
+// GetAuthor returns the recorded author of the BindingPolicy, nil when an author is not recorded
+func (r *BindingPolicy) GetAuthor() (*authenticationv1.UserInfo, error) {
+	return getAuthor(r.Annotations)
+}
+
+// SetAuthor records the author of the BindingPolicy, a nil author removes the recorded author
+func (r *BindingPolicy) SetAuthor(author *authenticationv1.UserInfo) {
+	setAuthor(&r.ObjectMeta, author)
+}
+
+func getAuthor(annotations map[string]string) (*authenticationv1.UserInfo, error) {
+	value, ok := annotations[ServiceBindingAuthorAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	author := &authenticationv1.UserInfo{}
+	if err := json.Unmarshal([]byte(value), author); err != nil {
+		return nil, err
+	}
+	return author, nil
+}
+
+func setAuthor(obj *metav1.ObjectMeta, author *authenticationv1.UserInfo) {
+	if author == nil {
+		delete(obj.Annotations, ServiceBindingAuthorAnnotation)
+		return
+	}
+	// a UserInfo is always able to be marshaled
+	value, _ := json.Marshal(author)
+	if obj.Annotations == nil {
+		obj.Annotations = map[string]string{}
+	}
+	obj.Annotations[ServiceBindingAuthorAnnotation] = string(value)
+}
+
+// recordAuthor records the user making the request as the author of the object when the object is created or its
+// spec is changed. Other updates preserve the author recorded on the old object. The old object of an update is
+// decoded by decodeOld, which also reports whether the spec changed.
+func recordAuthor(ctx context.Context, obj *metav1.ObjectMeta, decodeOld func(raw []byte) (*metav1.ObjectMeta, bool, error)) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if req.Operation == admissionv1.Update {
+		old, specChanged, err := decodeOld(req.OldObject.Raw)
+		if err != nil {
+			return err
+		}
+		if !specChanged {
+			if author, ok := old.Annotations[ServiceBindingAuthorAnnotation]; ok {
+				if obj.Annotations == nil {
+					obj.Annotations = map[string]string{}
+				}
+				obj.Annotations[ServiceBindingAuthorAnnotation] = author
+			} else {
+				delete(obj.Annotations, ServiceBindingAuthorAnnotation)
+			}
+			return nil
+		}
+	}
+	u := req.UserInfo
+	setAuthor(obj, &u)
+	return nil
+}
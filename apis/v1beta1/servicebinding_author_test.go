@@ -0,0 +1,295 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestServiceBindingAuthor(t *testing.T) {
+	author := &authenticationv1.UserInfo{
+		Username: "alice",
+		UID:      "1234",
+		Groups:   []string{"system:authenticated", "developers"},
+		Extra: map[string]authenticationv1.ExtraValue{
+			"scopes": {"a", "b"},
+		},
+	}
+
+	binding := &ServiceBinding{}
+	if actual, err := binding.GetAuthor(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if actual != nil {
+		t.Errorf("expected no author, got %v", actual)
+	}
+
+	binding.SetAuthor(author)
+	if actual, err := binding.GetAuthor(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if diff := cmp.Diff(author, actual); diff != "" {
+		t.Errorf("GetAuthor() (-expected, +actual): %s", diff)
+	}
+
+	binding.SetAuthor(nil)
+	if _, ok := binding.Annotations[ServiceBindingAuthorAnnotation]; ok {
+		t.Errorf("expected author annotation to be removed")
+	}
+
+	binding.Annotations = map[string]string{
+		ServiceBindingAuthorAnnotation: "not json",
+	}
+	if _, err := binding.GetAuthor(); err == nil {
+		t.Errorf("expected error for malformed author")
+	}
+}
+
+func TestServiceBindingDefaulter(t *testing.T) {
+	alice := authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}}
+	bob := authenticationv1.UserInfo{Username: "bob", Groups: []string{"system:authenticated"}}
+
+	authored := func(author *authenticationv1.UserInfo) *ServiceBinding {
+		binding := &ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-binding",
+			},
+			Spec: ServiceBindingSpec{
+				Service: ServiceBindingServiceReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "my-secret",
+				},
+				Workload: ServiceBindingWorkloadReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "my-workload",
+				},
+			},
+		}
+		binding.SetAuthor(author)
+		return binding
+	}
+	raw := func(obj runtime.Object) runtime.RawExtension {
+		b, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatalf("unable to marshal: %v", err)
+		}
+		return runtime.RawExtension{Raw: b}
+	}
+
+	tests := []struct {
+		name     string
+		request  admissionv1.AdmissionRequest
+		given    *ServiceBinding
+		expected *ServiceBinding
+	}{
+		{
+			name: "create records the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				UserInfo:  alice,
+			},
+			given: authored(nil),
+			expected: func() *ServiceBinding {
+				binding := authored(&alice)
+				binding.Spec.Name = "my-binding"
+				return binding
+			}(),
+		},
+		{
+			name: "create replaces an author set by the user",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				UserInfo:  alice,
+			},
+			given: authored(&bob),
+			expected: func() *ServiceBinding {
+				binding := authored(&alice)
+				binding.Spec.Name = "my-binding"
+				return binding
+			}(),
+		},
+		{
+			name: "update of the spec records the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  bob,
+				OldObject: raw(authored(&alice)),
+			},
+			given: func() *ServiceBinding {
+				binding := authored(&alice)
+				binding.Spec.Type = "mysql"
+				return binding
+			}(),
+			expected: func() *ServiceBinding {
+				binding := authored(&bob)
+				binding.Spec.Name = "my-binding"
+				binding.Spec.Type = "mysql"
+				return binding
+			}(),
+		},
+		{
+			name: "update without spec changes preserves the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  bob,
+				OldObject: raw(authored(&alice)),
+			},
+			given: func() *ServiceBinding {
+				binding := authored(&bob)
+				binding.Finalizers = []string{"servicebinding.io/finalizer"}
+				return binding
+			}(),
+			expected: func() *ServiceBinding {
+				binding := authored(&alice)
+				binding.Spec.Name = "my-binding"
+				binding.Finalizers = []string{"servicebinding.io/finalizer"}
+				return binding
+			}(),
+		},
+		{
+			name: "update without spec changes does not record an author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  bob,
+				OldObject: raw(authored(nil)),
+			},
+			given: authored(&bob),
+			expected: func() *ServiceBinding {
+				binding := authored(nil)
+				binding.Annotations = map[string]string{}
+				binding.Spec.Name = "my-binding"
+				return binding
+			}(),
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.TODO(), admission.Request{AdmissionRequest: c.request})
+			actual := c.given.DeepCopy()
+			if err := NewServiceBindingDefaulter().Default(ctx, actual); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Default() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBindingPolicyDefaulter(t *testing.T) {
+	alice := authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}}
+	bob := authenticationv1.UserInfo{Username: "bob", Groups: []string{"system:authenticated"}}
+
+	authored := func(author *authenticationv1.UserInfo) *BindingPolicy {
+		policy := &BindingPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-policy",
+			},
+			Spec: BindingPolicySpec{
+				Bindings: []BindingPolicyBinding{
+					{
+						Name: "db",
+						Service: ServiceBindingServiceReference{
+							APIVersion: "v1",
+							Kind:       "Secret",
+							Name:       "my-secret",
+						},
+					},
+				},
+			},
+		}
+		policy.SetAuthor(author)
+		return policy
+	}
+	raw := func(obj runtime.Object) runtime.RawExtension {
+		b, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatalf("unable to marshal: %v", err)
+		}
+		return runtime.RawExtension{Raw: b}
+	}
+
+	tests := []struct {
+		name     string
+		request  admissionv1.AdmissionRequest
+		given    *BindingPolicy
+		expected *BindingPolicy
+	}{
+		{
+			name: "create records the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				UserInfo:  alice,
+			},
+			given:    authored(&bob),
+			expected: authored(&alice),
+		},
+		{
+			name: "update of the spec records the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  bob,
+				OldObject: raw(authored(&alice)),
+			},
+			given: func() *BindingPolicy {
+				policy := authored(&alice)
+				policy.Spec.Bindings[0].Type = "mysql"
+				return policy
+			}(),
+			expected: func() *BindingPolicy {
+				policy := authored(&bob)
+				policy.Spec.Bindings[0].Type = "mysql"
+				return policy
+			}(),
+		},
+		{
+			name: "update without spec changes preserves the author",
+			request: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  bob,
+				OldObject: raw(authored(&alice)),
+			},
+			given:    authored(&bob),
+			expected: authored(&alice),
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.TODO(), admission.Request{AdmissionRequest: c.request})
+			actual := c.given.DeepCopy()
+			if err := NewBindingPolicyDefaulter().Default(ctx, actual); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Default() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
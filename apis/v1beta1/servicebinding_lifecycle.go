@@ -34,18 +34,32 @@ const (
 	// reference resolved to a ProvisionedService and found a secret. It does not
 	// indicate the condition of the Service.
 	ServiceBindingConditionServiceAvailable = "ServiceAvailable"
+	// ServiceBindingConditionBindingValid means the entries of the binding include
+	// the required type entry, the entries referenced by the env mappings, and the
+	// entries required by the BindingTypeSchema for the type, if any.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionBindingValid = "BindingValid"
 	// ServiceBindingConditionWorkloadProjected means the ServiceBinding has projected
 	// the ProvisionedService secret and the Workload is ready to start. It does not
 	// indicate the condition of the Workload resources referenced.
 	//
 	// Not a standardized condition.
 	ServiceBindingConditionWorkloadProjected = "WorkloadProjected"
+	// ServiceBindingConditionPolicyCompliant means the ServiceBinding is admitted by
+	// every ServiceBindingPolicy selecting its namespace. A ServiceBinding that
+	// violates a policy is not projected into the workload.
+	//
+	// Not a standardized condition.
+	ServiceBindingConditionPolicyCompliant = "PolicyCompliant"
 )
 
 var servicebindingCondSet = apis.NewLivingConditionSetWithHappyReason(
 	"ServiceBound",
 	ServiceBindingConditionServiceAvailable,
+	ServiceBindingConditionBindingValid,
 	ServiceBindingConditionWorkloadProjected,
+	ServiceBindingConditionPolicyCompliant,
 )
 
 func (s *ServiceBinding) GetConditionsAccessor() apis.ConditionsAccessor {
@@ -65,7 +79,9 @@ func (s *ServiceBindingStatus) InitializeConditions() {
 	conditionManager.InitializeConditions()
 	// reset existing managed conditions
 	conditionManager.MarkUnknown(ServiceBindingConditionServiceAvailable, "Initializing", "")
+	conditionManager.MarkUnknown(ServiceBindingConditionBindingValid, "Initializing", "")
 	conditionManager.MarkUnknown(ServiceBindingConditionWorkloadProjected, "Initializing", "")
+	conditionManager.MarkUnknown(ServiceBindingConditionPolicyCompliant, "Initializing", "")
 }
 
 var _ apis.ConditionsAccessor = (*ServiceBindingStatus)(nil)
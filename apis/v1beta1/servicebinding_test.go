@@ -91,7 +91,7 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "name"), ""),
 				field.Required(field.NewPath("spec", "service", "apiVersion"), ""),
 				field.Required(field.NewPath("spec", "service", "kind"), ""),
-				field.Required(field.NewPath("spec", "service", "name"), ""),
+				field.Required(field.NewPath("spec", "service", "[name, selector]"), "expected exactly one, got neither"),
 				field.Required(field.NewPath("spec", "workload", "apiVersion"), ""),
 				field.Required(field.NewPath("spec", "workload", "kind"), ""),
 				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got neither"),
@@ -190,6 +190,340 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "workload", "[name, selector]"), "expected exactly one, got both"),
 			},
 		},
+		{
+			name: "service valid selector",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "db"},
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "service invalid overspeced",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-service",
+						Selector:   &metav1.LabelSelector{},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "service", "[name, selector]"), "expected exactly one, got both"),
+			},
+		},
+		{
+			name: "service in another namespace",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Namespace:  "shared-services",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "service invalid namespace",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Namespace:  "Shared_Services",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "service", "namespace"), "Shared_Services", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		{
+			name: "valid fallback services",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-blue-service",
+					},
+					FallbackServices: []ServiceBindingServiceReference{
+						{
+							APIVersion: "example/v1",
+							Kind:       "MyService",
+							Name:       "my-green-service",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid fallback services",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-blue-service",
+					},
+					FallbackServices: []ServiceBindingServiceReference{
+						{
+							APIVersion: "example/v1",
+							Kind:       "MyService",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "fallbackServices").Index(0).Child("[name, selector]"), "expected exactly one, got neither"),
+			},
+		},
+		{
+			name: "valid additional services",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-replica",
+							},
+						},
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-kafka",
+							},
+							Path: "kafka/east",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "additional services with duplicate paths",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-kafka-east",
+							},
+							Path: "kafka",
+						},
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-kafka-west",
+							},
+							Path: "kafka",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "additionalServices", "[0, 1]", "path"), "kafka"),
+			},
+		},
+		{
+			name: "additional service with absolute path",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-kafka",
+							},
+							Path: "/kafka",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "additionalServices").Index(0).Child("path"), "/kafka", "must be a relative path"),
+			},
+		},
+		{
+			name: "additional service with parent path",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Name:       "my-kafka",
+							},
+							Path: "kafka/../..",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "additionalServices").Index(0).Child("path"), "kafka/../..", "must not contain '.' or '..' segments"),
+			},
+		},
+		{
+			name: "additional service in another namespace",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							ServiceBindingServiceReference: ServiceBindingServiceReference{
+								APIVersion: "example/v1",
+								Kind:       "MyService",
+								Namespace:  "shared-services",
+								Name:       "my-kafka",
+							},
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "additionalServices").Index(0).Child("namespace"), "additional services must be in the namespace of the ServiceBinding"),
+			},
+		},
+		{
+			name: "additional service missing reference",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "example/v1",
+						Kind:       "MyService",
+						Name:       "my-primary",
+					},
+					AdditionalServices: []ServiceBindingAdditionalServiceReference{
+						{
+							Path: "kafka",
+						},
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "additionalServices").Index(0).Child("apiVersion"), ""),
+				field.Required(field.NewPath("spec", "additionalServices").Index(0).Child("kind"), ""),
+				field.Required(field.NewPath("spec", "additionalServices").Index(0).Child("[name, selector]"), "expected exactly one, got neither"),
+			},
+		},
 		{
 			name: "workload valid env",
 			seed: &ServiceBinding{
@@ -246,6 +580,72 @@ func TestServiceBindingValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "env[1]", "key"), ""),
 			},
 		},
+		{
+			name: "transform",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Transform: &ServiceBindingTransform{
+						Entries: []ServiceBindingTransformEntry{
+							{Key: "uri", Value: "postgresql://{{ .host }}:{{ .port }}"},
+						},
+						Renames: []ServiceBindingTransformRename{
+							{From: "user", To: "username"},
+						},
+						Drop: []string{"certificate"},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid transform",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-service",
+					},
+					Workload: ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deloyment",
+						Name:       "my-workload",
+					},
+					Transform: &ServiceBindingTransform{
+						Entries: []ServiceBindingTransformEntry{
+							{Key: "uri", Value: "{{ .host }}"},
+							{Key: "uri", Value: "{{ .host"},
+						},
+						Renames: []ServiceBindingTransformRename{
+							{From: "user", To: "username"},
+							{From: "login", To: "username"},
+							{},
+						},
+						Drop: []string{"not/a/key"},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "transform", "entries", "[0, 1]", "key"), "uri"),
+				field.Invalid(field.NewPath("spec", "transform", "entries").Index(1).Child("value"), "{{ .host", "template: uri:1: unclosed action"),
+				field.Duplicate(field.NewPath("spec", "transform", "renames", "[0, 1]", "to"), "username"),
+				field.Required(field.NewPath("spec", "transform", "renames").Index(2).Child("from"), ""),
+				field.Required(field.NewPath("spec", "transform", "renames").Index(2).Child("to"), ""),
+				field.Invalid(field.NewPath("spec", "transform", "drop").Index(0), "not/a/key", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+			},
+		},
 	}
 
 	for _, c := range tests {
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrBindingTransform is wrapped by errors for transformed entries that can not be rendered
+var ErrBindingTransform = errors.New("unable to transform binding secret")
+
+// Apply transforms the entries of a binding secret. Templates are evaluated against the string values of the entries
+// and only have access to the built-in template functions, see ExecuteTemplate for the limits.
+func (r *ServiceBindingTransform) Apply(data map[string][]byte) (map[string][]byte, error) {
+	values := make(map[string]string, len(data))
+	transformed := make(map[string][]byte, len(data))
+	for k, v := range data {
+		values[k] = string(v)
+		transformed[k] = v
+	}
+
+	for _, rename := range r.Renames {
+		v, ok := transformed[rename.From]
+		if !ok {
+			return nil, fmt.Errorf("%w: rename %q: entry not found", ErrBindingTransform, rename.From)
+		}
+		delete(transformed, rename.From)
+		transformed[rename.To] = v
+	}
+	for _, key := range r.Drop {
+		delete(transformed, key)
+	}
+	for _, entry := range r.Entries {
+		value, err := ExecuteTemplate(entry.Key, entry.Value, values)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %q: %s", ErrBindingTransform, entry.Key, err)
+		}
+		transformed[entry.Key] = value
+	}
+
+	return transformed, nil
+}
@@ -34,18 +34,39 @@ type ServiceBindingWorkloadReference struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 	// Containers describes which containers in a Pod should be bound to
 	Containers []string `json:"containers,omitempty"`
+	// PodTemplates describes which named pod templates in the workload should be bound to. Only applies to workloads
+	// whose mapping defines pod templates, all pod templates are bound to when empty.
+	PodTemplates []string `json:"podTemplates,omitempty"`
 }
 
-// ServiceBindingServiceReference defines a subset of corev1.ObjectReference
+// ServiceBindingServiceReference defines a subset of corev1.ObjectReference with extensions
 type ServiceBindingServiceReference struct {
 	// API version of the referent.
 	APIVersion string `json:"apiVersion"`
 	// Kind of the referent.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
 	Kind string `json:"kind"`
+	// Namespace of the referent, defaults to the namespace of the ServiceBinding. A service in another namespace must
+	// be granted to the namespace of the ServiceBinding by a ReferenceGrant in the namespace of the service, the binding
+	// secret of the service is copied into the namespace of the ServiceBinding. Binding config maps are not copied.
+	Namespace string `json:"namespace,omitempty"`
 	// Name of the referent.
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-	Name string `json:"name"`
+	Name string `json:"name,omitempty"`
+	// Selector is a query that selects the service within the namespace. When more than one service matches, services
+	// are preferred by creation timestamp, oldest first, and then by name. The first service with a binding secret is
+	// used.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ServiceBindingAdditionalServiceReference references a service whose binding secret is projected alongside the
+// binding secret of the primary service
+type ServiceBindingAdditionalServiceReference struct {
+	ServiceBindingServiceReference `json:",inline"`
+	// Path is the subdirectory within the binding that the entries of the service's binding secret are projected into.
+	// When empty, the entries are merged with the entries of the primary service and must not conflict with entries
+	// of other services.
+	Path string `json:"path,omitempty"`
 }
 
 // ServiceBindingSecretReference defines a mirror of corev1.LocalObjectReference
@@ -55,11 +76,19 @@ type ServiceBindingSecretReference struct {
 	Name string `json:"name"`
 }
 
-// EnvMapping defines a mapping from the value of a Secret entry to an environment variable
+// ServiceBindingConfigMapReference defines a mirror of corev1.LocalObjectReference
+type ServiceBindingConfigMapReference struct {
+	// Name of the referent config map.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	Name string `json:"name"`
+}
+
+// EnvMapping defines a mapping from the value of a Secret or ConfigMap entry to an environment variable
 type EnvMapping struct {
 	// Name is the name of the environment variable
 	Name string `json:"name"`
-	// Key is the key in the Secret that will be exposed
+	// Key is the key in the Secret that will be exposed. When the binding only has a ConfigMap, the key in the
+	// ConfigMap is exposed
 	Key string `json:"key"`
 }
 
@@ -73,10 +102,51 @@ type ServiceBindingSpec struct {
 	Provider string `json:"provider,omitempty"`
 	// Workload is a reference to an object
 	Workload ServiceBindingWorkloadReference `json:"workload"`
-	// Service is a reference to an object that fulfills the ProvisionedService duck type
+	// Service is a reference to an object that fulfills the ProvisionedService duck type. A Secret or ConfigMap may be
+	// referenced directly, a ConfigMap is intended for bindings without sensitive values
 	Service ServiceBindingServiceReference `json:"service"`
-	// Env is the collection of mappings from Secret entries to environment variables
+	// FallbackServices is an ordered collection of references to services that are used when the service, and each
+	// prior fallback service, does not provide a binding secret
+	FallbackServices []ServiceBindingServiceReference `json:"fallbackServices,omitempty"`
+	// AdditionalServices is the collection of services whose binding secrets are projected into the same binding as
+	// the service. Environment variables are only mapped from the entries of the service.
+	AdditionalServices []ServiceBindingAdditionalServiceReference `json:"additionalServices,omitempty"`
+	// Env is the collection of mappings from Secret or ConfigMap entries to environment variables
 	Env []EnvMapping `json:"env,omitempty"`
+	// Transform derives the entries of the projected binding secret from the entries of the service's binding secret.
+	// The transformed entries are materialized into a Secret owned by the ServiceBinding.
+	Transform *ServiceBindingTransform `json:"transform,omitempty"`
+}
+
+// ServiceBindingTransform defines how the entries of a binding secret are transformed before they are projected. The
+// entries of the binding secret are renamed, then dropped, and finally the derived entries are added.
+type ServiceBindingTransform struct {
+	// Entries are derived from the entries of the binding secret. An entry replaces an existing entry with the same
+	// key.
+	Entries []ServiceBindingTransformEntry `json:"entries,omitempty"`
+	// Renames move the value of an entry to a new key
+	Renames []ServiceBindingTransformRename `json:"renames,omitempty"`
+	// Drop is the collection of keys removed from the binding secret
+	Drop []string `json:"drop,omitempty"`
+}
+
+// ServiceBindingTransformEntry defines an entry derived from the entries of the binding secret
+type ServiceBindingTransformEntry struct {
+	// Key is the name of the derived entry
+	Key string `json:"key"`
+	// Value is a Go text/template evaluated against the entries of the binding secret before the transform is
+	// applied, like `postgresql://{{ .username }}:{{ .password }}@{{ .host }}:{{ .port }}/{{ .database }}`.
+	// Referencing an entry that does not exist, rendering more than 1MiB or executing for more than a second is an
+	// error.
+	Value string `json:"value"`
+}
+
+// ServiceBindingTransformRename defines an entry that is moved to a new key
+type ServiceBindingTransformRename struct {
+	// From is the key of the entry in the binding secret
+	From string `json:"from"`
+	// To is the key of the entry in the transformed binding secret
+	To string `json:"to"`
 }
 
 // ServiceBindingStatus defines the observed state of ServiceBinding
@@ -90,12 +160,61 @@ type ServiceBindingStatus struct {
 
 	// Binding exposes the projected secret for this ServiceBinding
 	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
+
+	// Service is the service that provides the binding secret, either the referenced service, a service matching the
+	// selector or a fallback service
+	Service *ServiceBindingServiceReference `json:"service,omitempty"`
+
+	// ReferenceGrant records the grant that permits the reference to the service in another namespace, and the binding
+	// secret copied from that namespace
+	ReferenceGrant *ServiceBindingReferenceGrantStatus `json:"referenceGrant,omitempty"`
+
+	// ConfigMap exposes the projected config map for this ServiceBinding. The entries of the config map are projected
+	// alongside the entries of the binding secret
+	ConfigMap *ServiceBindingConfigMapReference `json:"configMap,omitempty"`
+
+	// AdditionalServices reports the state of each additional service, in the same order as the spec
+	AdditionalServices []ServiceBindingAdditionalServiceStatus `json:"additionalServices,omitempty"`
+
+	// Type is the type of the binding, from the spec when defined, otherwise from the `type` entry of the binding
+	Type string `json:"type,omitempty"`
+
+	// Provider is the provider of the binding, from the spec when defined, otherwise from the `provider` entry of the
+	// binding
+	Provider string `json:"provider,omitempty"`
+}
+
+// ServiceBindingReferenceGrantStatus defines the observed grant for a service in another namespace
+type ServiceBindingReferenceGrantStatus struct {
+	// Namespace of the service and of the ReferenceGrant
+	Namespace string `json:"namespace"`
+	// Name of the ReferenceGrant
+	Name string `json:"name"`
+	// Secret is the name of the binding secret, in the namespace of the service, that is copied into the namespace of
+	// the ServiceBinding
+	Secret string `json:"secret,omitempty"`
+}
+
+// ServiceBindingAdditionalServiceStatus defines the observed state of an additional service
+type ServiceBindingAdditionalServiceStatus struct {
+	// Path is the subdirectory within the binding that the service is projected into
+	Path string `json:"path,omitempty"`
+	// Service is the service that provides the binding secret
+	Service *ServiceBindingServiceReference `json:"service,omitempty"`
+	// Binding exposes the projected secret of the service. Not defined when the secret is not projected.
+	Binding *ServiceBindingSecretReference `json:"binding,omitempty"`
+	// Keys are the entries of the binding secret projected into the path. Only defined when the path is defined.
+	Keys []string `json:"keys,omitempty"`
+	// Conditions are the conditions of the additional service
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.status.binding.name`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.type`
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.status.provider`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
@@ -17,16 +17,28 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func (r *ServiceBinding) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(NewServiceBindingDefaulter()).
+		WithValidator(NewServiceBindingValidator(mgr.GetClient())).
 		Complete()
 }
 
@@ -39,6 +51,33 @@ func (r *ServiceBinding) Default() {
 	}
 }
 
+//+kubebuilder:webhook:path=/mutate-servicebinding-io-v1beta1-servicebinding,mutating=true,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=servicebindings,verbs=create;update,versions={v1alpha3,v1beta1},name=mservicebinding.kb.io,admissionReviewVersions={v1,v1beta1}
+
+// NewServiceBindingDefaulter defaults ServiceBindings and records the user making the request as the author of the
+// ServiceBinding. The author is recorded when the ServiceBinding is created or its spec is changed, other updates
+// preserve the recorded author so that updates by the controller do not take over the ServiceBinding.
+func NewServiceBindingDefaulter() admission.CustomDefaulter {
+	return &serviceBindingDefaulter{}
+}
+
+// +kubebuilder:object:generate=false
+type serviceBindingDefaulter struct{}
+
+// Default implements admission.CustomDefaulter
+func (d *serviceBindingDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	r := obj.(*ServiceBinding)
+	r.Default()
+
+	return recordAuthor(ctx, &r.ObjectMeta, func(raw []byte) (*metav1.ObjectMeta, bool, error) {
+		old := &ServiceBinding{}
+		if err := json.Unmarshal(raw, old); err != nil {
+			return nil, false, err
+		}
+		old.Default()
+		return &old.ObjectMeta, !equality.Semantic.DeepEqual(old.Spec, r.Spec), nil
+	})
+}
+
 //+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-servicebinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=servicebindings,verbs=create;update,versions={v1alpha3,v1beta1},name=vservicebinding.kb.io,admissionReviewVersions={v1,v1beta1}
 
 var _ webhook.Validator = &ServiceBinding{}
@@ -61,6 +100,55 @@ func (r *ServiceBinding) ValidateDelete() error {
 	return nil
 }
 
+// NewServiceBindingValidator validates ServiceBindings and enforces the ServiceBindingPolicies selecting the namespace
+// of the ServiceBinding. Policies are only enforced when the spec of the ServiceBinding is created or changed, so that
+// the controller is able to finalize ServiceBindings that violate a policy.
+func NewServiceBindingValidator(c client.Reader) admission.CustomValidator {
+	return &serviceBindingValidator{client: c}
+}
+
+// +kubebuilder:object:generate=false
+type serviceBindingValidator struct {
+	client client.Reader
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *serviceBindingValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	r := obj.(*ServiceBinding)
+	if err := r.ValidateCreate(); err != nil {
+		return err
+	}
+	return v.admit(ctx, r)
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *serviceBindingValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	r := newObj.(*ServiceBinding)
+	if err := r.ValidateUpdate(oldObj); err != nil {
+		return err
+	}
+	if old, ok := oldObj.(*ServiceBinding); ok {
+		old.Default()
+		if equality.Semantic.DeepEqual(old.Spec, r.Spec) {
+			return nil
+		}
+	}
+	return v.admit(ctx, r)
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *serviceBindingValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return obj.(*ServiceBinding).ValidateDelete()
+}
+
+func (v *serviceBindingValidator) admit(ctx context.Context, r *ServiceBinding) error {
+	errs, err := AdmitServiceBinding(ctx, v.client, r)
+	if err != nil {
+		return err
+	}
+	return errs.ToAggregate()
+}
+
 func (r *ServiceBinding) validate() field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -76,10 +164,26 @@ func (r *ServiceBindingSpec) validate(fldPath *field.Path) field.ErrorList {
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
 	errs = append(errs, r.Service.validate(fldPath.Child("service"))...)
+	for i := range r.FallbackServices {
+		errs = append(errs, r.FallbackServices[i].validate(fldPath.Child("fallbackServices").Index(i))...)
+	}
+	paths := map[string]int{}
+	for i := range r.AdditionalServices {
+		errs = append(errs, r.AdditionalServices[i].validate(fldPath.Child("additionalServices").Index(i))...)
+		if path := r.AdditionalServices[i].Path; path != "" {
+			if p, ok := paths[path]; ok {
+				errs = append(errs, field.Duplicate(fldPath.Child("additionalServices").Child(fmt.Sprintf("[%d, %d]", p, i), "path"), path))
+			}
+			paths[path] = i
+		}
+	}
 	errs = append(errs, r.Workload.validate(fldPath.Child("workload"))...)
 	for i := range r.Env {
 		errs = append(errs, r.Env[i].validate(fldPath.Child("env").Index(i))...)
 	}
+	if r.Transform != nil {
+		errs = append(errs, r.Transform.validate(fldPath.Child("transform"))...)
+	}
 
 	return errs
 }
@@ -93,8 +197,49 @@ func (r *ServiceBindingServiceReference) validate(fldPath *field.Path) field.Err
 	if r.Kind == "" {
 		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
 	}
-	if r.Name == "" {
-		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	if r.Namespace != "" {
+		for _, msg := range validation.IsDNS1123Label(r.Namespace) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, msg))
+		}
+	}
+	if r.Name == "" && r.Selector == nil {
+		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got neither"))
+	}
+	if r.Name != "" && r.Selector != nil {
+		errs = append(errs, field.Required(fldPath.Child("[name, selector]"), "expected exactly one, got both"))
+	}
+	if r.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Selector); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("selector"), r.Selector, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingAdditionalServiceReference) validate(fldPath *field.Path) field.ErrorList {
+	errs := r.ServiceBindingServiceReference.validate(fldPath)
+
+	if r.Namespace != "" {
+		// the binding secret of an additional service is projected directly, it is not copied between namespaces
+		errs = append(errs, field.Forbidden(fldPath.Child("namespace"), "additional services must be in the namespace of the ServiceBinding"))
+	}
+
+	if r.Path != "" {
+		if strings.HasPrefix(r.Path, "/") {
+			errs = append(errs, field.Invalid(fldPath.Child("path"), r.Path, "must be a relative path"))
+		} else {
+			for _, segment := range strings.Split(r.Path, "/") {
+				if segment == "." || segment == ".." {
+					errs = append(errs, field.Invalid(fldPath.Child("path"), r.Path, "must not contain '.' or '..' segments"))
+					break
+				}
+				if msgs := validation.IsConfigMapKey(segment); len(msgs) != 0 {
+					errs = append(errs, field.Invalid(fldPath.Child("path"), r.Path, strings.Join(msgs, ", ")))
+					break
+				}
+			}
+		}
 	}
 
 	return errs
@@ -136,3 +281,64 @@ func (r *EnvMapping) validate(fldPath *field.Path) field.ErrorList {
 
 	return errs
 }
+
+func (r *ServiceBindingTransform) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	keys := map[string]int{}
+	for i := range r.Entries {
+		// check for duplicate keys
+		if p, ok := keys[r.Entries[i].Key]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child("entries", fmt.Sprintf("[%d, %d]", p, i), "key"), r.Entries[i].Key))
+		}
+		keys[r.Entries[i].Key] = i
+		errs = append(errs, r.Entries[i].validate(fldPath.Child("entries").Index(i))...)
+	}
+	tos := map[string]int{}
+	for i := range r.Renames {
+		if p, ok := tos[r.Renames[i].To]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child("renames", fmt.Sprintf("[%d, %d]", p, i), "to"), r.Renames[i].To))
+		}
+		tos[r.Renames[i].To] = i
+		errs = append(errs, r.Renames[i].validate(fldPath.Child("renames").Index(i))...)
+	}
+	for i, key := range r.Drop {
+		errs = append(errs, validateBindingKey(key, fldPath.Child("drop").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingTransformEntry) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validateBindingKey(r.Key, fldPath.Child("key"))...)
+	if _, err := template.New(r.Key).Parse(r.Value); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("value"), r.Value, err.Error()))
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingTransformRename) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validateBindingKey(r.From, fldPath.Child("from"))...)
+	errs = append(errs, validateBindingKey(r.To, fldPath.Child("to"))...)
+
+	return errs
+}
+
+func validateBindingKey(key string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if key == "" {
+		errs = append(errs, field.Required(fldPath, ""))
+		return errs
+	}
+	for _, msg := range validation.IsConfigMapKey(key) {
+		errs = append(errs, field.Invalid(fldPath, key, msg))
+	}
+
+	return errs
+}
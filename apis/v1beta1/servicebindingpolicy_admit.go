@@ -0,0 +1,191 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AdmitServiceBinding returns the violations of the ServiceBindingPolicies selecting the namespace of the
+// ServiceBinding.
+func AdmitServiceBinding(ctx context.Context, c client.Reader, binding *ServiceBinding) (field.ErrorList, error) {
+	policies, err := selectingPolicies(ctx, c, binding.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := field.ErrorList{}
+	for i := range policies {
+		errs = append(errs, policies[i].Admit(binding)...)
+	}
+	return errs, nil
+}
+
+// AdmitServiceBindingType returns the violations of the ServiceBindingPolicies selecting the namespace of the
+// ServiceBinding by the type of the resolved binding. The type of the spec is admitted by AdmitServiceBinding, while
+// the type of the binding comes from the service and may differ from the spec.
+func AdmitServiceBindingType(ctx context.Context, c client.Reader, binding *ServiceBinding, bindingType string) (field.ErrorList, error) {
+	policies, err := selectingPolicies(ctx, c, binding.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := field.ErrorList{}
+	for i := range policies {
+		errs = append(errs, policies[i].AdmitType(bindingType, field.NewPath("status", "type"))...)
+	}
+	return errs, nil
+}
+
+// selectingPolicies returns the ServiceBindingPolicies selecting the namespace
+func selectingPolicies(ctx context.Context, c client.Reader, namespace string) ([]ServiceBindingPolicy, error) {
+	policies := &ServiceBindingPolicyList{}
+	if err := c.List(ctx, policies); err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil && !apierrs.IsNotFound(err) {
+		return nil, err
+	}
+
+	selected := []ServiceBindingPolicy{}
+	for i := range policies.Items {
+		if policies.Items[i].Selects(ns.Labels) {
+			selected = append(selected, policies.Items[i])
+		}
+	}
+	return selected, nil
+}
+
+// Selects returns true when the policy applies to ServiceBindings in a namespace with the labels. A selector that
+// cannot be parsed selects every namespace, so that a malformed policy is enforced rather than ignored.
+func (r *ServiceBindingPolicy) Selects(namespaceLabels map[string]string) bool {
+	if r.Spec.NamespaceSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(r.Spec.NamespaceSelector)
+	if err != nil {
+		return true
+	}
+	return selector.Matches(labels.Set(namespaceLabels))
+}
+
+// Admit returns the violations of the policy by the ServiceBinding, regardless of the namespace of the ServiceBinding.
+func (r *ServiceBindingPolicy) Admit(binding *ServiceBinding) field.ErrorList {
+	errs := field.ErrorList{}
+	fldPath := field.NewPath("spec")
+
+	if len(r.Spec.Services) != 0 {
+		errs = append(errs, r.admitService(binding.Spec.Service, fldPath.Child("service"))...)
+		for i := range binding.Spec.FallbackServices {
+			errs = append(errs, r.admitService(binding.Spec.FallbackServices[i], fldPath.Child("fallbackServices").Index(i))...)
+		}
+		for i := range binding.Spec.AdditionalServices {
+			errs = append(errs, r.admitService(binding.Spec.AdditionalServices[i].ServiceBindingServiceReference, fldPath.Child("additionalServices").Index(i))...)
+		}
+	}
+	if len(r.Spec.Workloads) != 0 {
+		gk := schema.FromAPIVersionAndKind(binding.Spec.Workload.APIVersion, binding.Spec.Workload.Kind).GroupKind()
+		if !permitsResource(r.Spec.Workloads, gk) {
+			errs = append(errs, field.Forbidden(fldPath.Child("workload"), fmt.Sprintf("the workload kind %q is not permitted by the ServiceBindingPolicy %q", gk, r.Name)))
+		}
+	}
+	if len(r.Spec.Types) != 0 {
+		switch {
+		case binding.Spec.Type == "":
+			errs = append(errs, field.Required(fldPath.Child("type"), fmt.Sprintf("the ServiceBindingPolicy %q requires one of the types %s", r.Name, quoteAll(r.Spec.Types))))
+		default:
+			errs = append(errs, r.AdmitType(binding.Spec.Type, fldPath.Child("type"))...)
+		}
+	}
+	if len(r.Spec.DeniedContainers) != 0 {
+		containersPath := fldPath.Child("workload", "containers")
+		if len(binding.Spec.Workload.Containers) == 0 {
+			errs = append(errs, field.Required(containersPath, fmt.Sprintf("the ServiceBindingPolicy %q denies binding the containers %s", r.Name, quoteAll(r.Spec.DeniedContainers))))
+		}
+		for i, container := range binding.Spec.Workload.Containers {
+			if contains(r.Spec.DeniedContainers, container) {
+				errs = append(errs, field.Forbidden(containersPath.Index(i), fmt.Sprintf("the container %q is denied by the ServiceBindingPolicy %q", container, r.Name)))
+			}
+		}
+	}
+
+	return errs
+}
+
+// AdmitType returns the violation of the policy by the type of a binding. Any type is admitted when the policy doesn't
+// restrict types.
+func (r *ServiceBindingPolicy) AdmitType(bindingType string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(r.Spec.Types) != 0 && !contains(r.Spec.Types, bindingType) {
+		errs = append(errs, field.Forbidden(fldPath, fmt.Sprintf("the type %q is not permitted by the ServiceBindingPolicy %q", bindingType, r.Name)))
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingPolicy) admitService(service ServiceBindingServiceReference, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	gk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind).GroupKind()
+	if !permitsResource(r.Spec.Services, gk) {
+		errs = append(errs, field.Forbidden(fldPath, fmt.Sprintf("the service kind %q is not permitted by the ServiceBindingPolicy %q", gk, r.Name)))
+	}
+
+	return errs
+}
+
+func permitsResource(resources []ServiceBindingPolicyResource, gk schema.GroupKind) bool {
+	for _, resource := range resources {
+		if resource.Group == gk.Group && (resource.Kind == "*" || resource.Kind == gk.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i := range values {
+		quoted[i] = fmt.Sprintf("%q", values[i])
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,522 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestServiceBindingPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingPolicy
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &ServiceBindingPolicy{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "valid",
+			seed: &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "a"},
+					},
+					Services: []ServiceBindingPolicyResource{
+						{Group: "", Kind: "Secret"},
+						{Group: "example.com", Kind: "*"},
+					},
+					Workloads: []ServiceBindingPolicyResource{
+						{Group: "apps", Kind: "Deployment"},
+					},
+					Types:            []string{"mysql", "postgresql"},
+					DeniedContainers: []string{"istio-proxy"},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "invalid namespace selector",
+			seed: &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: metav1.LabelSelectorOpIn},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "namespaceSelector"), &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "team", Operator: metav1.LabelSelectorOpIn},
+					},
+				}, "values: Invalid value: []string(nil): for 'in', 'notin' operators, values set can't be empty"),
+			},
+		},
+		{
+			name: "missing kinds",
+			seed: &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					Services: []ServiceBindingPolicyResource{
+						{Group: "example.com"},
+					},
+					Workloads: []ServiceBindingPolicyResource{
+						{Group: "apps"},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "services").Index(0).Child("kind"), ""),
+				field.Required(field.NewPath("spec", "workloads").Index(0).Child("kind"), ""),
+			},
+		},
+		{
+			name: "invalid types",
+			seed: &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					Types: []string{"mysql", "", "mysql"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "types").Index(1), ""),
+				field.Duplicate(field.NewPath("spec", "types", "[0, 2]"), "mysql"),
+			},
+		},
+		{
+			name: "invalid denied containers",
+			seed: &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					DeniedContainers: []string{"Istio_Proxy"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "deniedContainers").Index(0), "Istio_Proxy", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingPolicySelects(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *metav1.LabelSelector
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "every namespace",
+			labels:   map[string]string{"team": "b"},
+			expected: true,
+		},
+		{
+			name: "selected namespace",
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "a"},
+			},
+			labels:   map[string]string{"team": "a"},
+			expected: true,
+		},
+		{
+			name: "other namespace",
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "a"},
+			},
+			labels:   map[string]string{"team": "b"},
+			expected: false,
+		},
+		{
+			name: "invalid selector selects every namespace",
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn},
+				},
+			},
+			labels:   map[string]string{"team": "b"},
+			expected: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			policy := &ServiceBindingPolicy{
+				Spec: ServiceBindingPolicySpec{
+					NamespaceSelector: c.selector,
+				},
+			}
+			if actual := policy.Selects(c.labels); actual != c.expected {
+				t.Errorf("Selects() = %v, expected %v", actual, c.expected)
+			}
+		})
+	}
+}
+
+func TestServiceBindingPolicyAdmit(t *testing.T) {
+	policy := &ServiceBindingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-policy",
+		},
+		Spec: ServiceBindingPolicySpec{
+			Services: []ServiceBindingPolicyResource{
+				{Group: "", Kind: "Secret"},
+				{Group: "example.com", Kind: "*"},
+			},
+			Workloads: []ServiceBindingPolicyResource{
+				{Group: "apps", Kind: "Deployment"},
+			},
+			Types:            []string{"mysql", "postgresql"},
+			DeniedContainers: []string{"istio-proxy"},
+		},
+	}
+	binding := &ServiceBinding{
+		Spec: ServiceBindingSpec{
+			Type: "mysql",
+			Service: ServiceBindingServiceReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "my-secret",
+			},
+			Workload: ServiceBindingWorkloadReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "my-workload",
+				Containers: []string{"app"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		policy   *ServiceBindingPolicy
+		seed     *ServiceBinding
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty policy admits",
+			policy:   &ServiceBindingPolicy{},
+			seed:     &ServiceBinding{},
+			expected: field.ErrorList{},
+		},
+		{
+			name:     "admitted",
+			policy:   policy,
+			seed:     binding,
+			expected: field.ErrorList{},
+		},
+		{
+			name:   "any kind of the group",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Service = ServiceBindingServiceReference{APIVersion: "example.com/v1", Kind: "MyService", Name: "my-service"}
+				return b
+			}(),
+			expected: field.ErrorList{},
+		},
+		{
+			name:   "service kinds not permitted",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Service = ServiceBindingServiceReference{APIVersion: "example.org/v1", Kind: "MyService", Name: "my-service"}
+				b.Spec.FallbackServices = []ServiceBindingServiceReference{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "my-config"},
+				}
+				b.Spec.AdditionalServices = []ServiceBindingAdditionalServiceReference{
+					{ServiceBindingServiceReference: ServiceBindingServiceReference{APIVersion: "v1", Kind: "Secret", Name: "my-ca"}},
+					{ServiceBindingServiceReference: ServiceBindingServiceReference{APIVersion: "v1", Kind: "Pod", Name: "my-pod"}},
+				}
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "service"), `the service kind "MyService.example.org" is not permitted by the ServiceBindingPolicy "my-policy"`),
+				field.Forbidden(field.NewPath("spec", "fallbackServices").Index(0), `the service kind "ConfigMap" is not permitted by the ServiceBindingPolicy "my-policy"`),
+				field.Forbidden(field.NewPath("spec", "additionalServices").Index(1), `the service kind "Pod" is not permitted by the ServiceBindingPolicy "my-policy"`),
+			},
+		},
+		{
+			name:   "workload kind not permitted",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Workload.Kind = "StatefulSet"
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "workload"), `the workload kind "StatefulSet.apps" is not permitted by the ServiceBindingPolicy "my-policy"`),
+			},
+		},
+		{
+			name:   "type required",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Type = ""
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "type"), `the ServiceBindingPolicy "my-policy" requires one of the types "mysql", "postgresql"`),
+			},
+		},
+		{
+			name:   "type not permitted",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Type = "redis"
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "type"), `the type "redis" is not permitted by the ServiceBindingPolicy "my-policy"`),
+			},
+		},
+		{
+			name:   "containers required",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Workload.Containers = nil
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "workload", "containers"), `the ServiceBindingPolicy "my-policy" denies binding the containers "istio-proxy"`),
+			},
+		},
+		{
+			name:   "container denied",
+			policy: policy,
+			seed: func() *ServiceBinding {
+				b := binding.DeepCopy()
+				b.Spec.Workload.Containers = []string{"app", "istio-proxy"}
+				return b
+			}(),
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "workload", "containers").Index(1), `the container "istio-proxy" is denied by the ServiceBindingPolicy "my-policy"`),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.policy.Admit(c.seed)); diff != "" {
+				t.Errorf("Admit (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingPolicyAdmitType(t *testing.T) {
+	fldPath := field.NewPath("status", "type")
+	tests := []struct {
+		name        string
+		policy      *ServiceBindingPolicy
+		bindingType string
+		expected    field.ErrorList
+	}{
+		{
+			name: "any type without permitted types",
+			policy: &ServiceBindingPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-policy",
+				},
+			},
+			bindingType: "mysql",
+			expected:    field.ErrorList{},
+		},
+		{
+			name: "permitted type",
+			policy: &ServiceBindingPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-policy",
+				},
+				Spec: ServiceBindingPolicySpec{
+					Types: []string{"mysql", "postgresql"},
+				},
+			},
+			bindingType: "mysql",
+			expected:    field.ErrorList{},
+		},
+		{
+			name: "forbidden type",
+			policy: &ServiceBindingPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-policy",
+				},
+				Spec: ServiceBindingPolicySpec{
+					Types: []string{"mysql", "postgresql"},
+				},
+			},
+			bindingType: "docker-registry",
+			expected: field.ErrorList{
+				field.Forbidden(fldPath, `the type "docker-registry" is not permitted by the ServiceBindingPolicy "my-policy"`),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.policy.AdmitType(c.bindingType, fldPath)); diff != "" {
+				t.Errorf("AdmitType (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(AddToScheme(scheme))
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "my-namespace",
+			Labels: map[string]string{"team": "a"},
+		},
+	}
+	policy := &ServiceBindingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-policy",
+		},
+		Spec: ServiceBindingPolicySpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "a"},
+			},
+			Types: []string{"mysql"},
+		},
+	}
+	binding := &ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-binding",
+		},
+		Spec: ServiceBindingSpec{
+			Type: "redis",
+			Service: ServiceBindingServiceReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "my-secret",
+			},
+			Workload: ServiceBindingWorkloadReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "my-workload",
+			},
+		},
+	}
+	violation := field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "type"), `the type "redis" is not permitted by the ServiceBindingPolicy "my-policy"`),
+	}.ToAggregate()
+
+	tests := []struct {
+		name     string
+		given    []runtime.Object
+		validate func(v *serviceBindingValidator) error
+		expected error
+	}{
+		{
+			name:  "create without policies",
+			given: []runtime.Object{namespace},
+			validate: func(v *serviceBindingValidator) error {
+				return v.ValidateCreate(context.TODO(), binding.DeepCopy())
+			},
+		},
+		{
+			name:  "create violating a policy",
+			given: []runtime.Object{namespace, policy},
+			validate: func(v *serviceBindingValidator) error {
+				return v.ValidateCreate(context.TODO(), binding.DeepCopy())
+			},
+			expected: violation,
+		},
+		{
+			name: "create in a namespace not selected by the policy",
+			given: []runtime.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-namespace"}},
+				policy,
+			},
+			validate: func(v *serviceBindingValidator) error {
+				return v.ValidateCreate(context.TODO(), binding.DeepCopy())
+			},
+		},
+		{
+			name:  "update violating a policy",
+			given: []runtime.Object{namespace, policy},
+			validate: func(v *serviceBindingValidator) error {
+				old := binding.DeepCopy()
+				old.Spec.Type = "mysql"
+				return v.ValidateUpdate(context.TODO(), old, binding.DeepCopy())
+			},
+			expected: violation,
+		},
+		{
+			name:  "update without spec changes is not subject to policies",
+			given: []runtime.Object{namespace, policy},
+			validate: func(v *serviceBindingValidator) error {
+				updated := binding.DeepCopy()
+				updated.Finalizers = nil
+				return v.ValidateUpdate(context.TODO(), binding.DeepCopy(), updated)
+			},
+		},
+		{
+			name:  "delete is not subject to policies",
+			given: []runtime.Object{namespace, policy},
+			validate: func(v *serviceBindingValidator) error {
+				return v.ValidateDelete(context.TODO(), binding.DeepCopy())
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			v := NewServiceBindingValidator(fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c.given...).Build()).(*serviceBindingValidator)
+			if diff := cmp.Diff(c.expected, c.validate(v)); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
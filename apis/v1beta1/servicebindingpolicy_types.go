@@ -0,0 +1,77 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceBindingPolicyResource defines a kind of resource permitted by the policy
+type ServiceBindingPolicyResource struct {
+	// Group of the resource, empty for the core API group.
+	Group string `json:"group"`
+	// Kind of the resource, `*` permits every kind in the group.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+}
+
+// ServiceBindingPolicySpec defines the desired state of ServiceBindingPolicy
+type ServiceBindingPolicySpec struct {
+	// NamespaceSelector selects the namespaces whose ServiceBindings are subject to the policy. When empty, the policy
+	// applies to every namespace.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Services are the kinds of services that may be bound, including fallback and additional services. When empty,
+	// every kind of service may be bound.
+	Services []ServiceBindingPolicyResource `json:"services,omitempty"`
+	// Workloads are the kinds of workloads that may be bound. When empty, every kind of workload may be bound.
+	Workloads []ServiceBindingPolicyResource `json:"workloads,omitempty"`
+	// Types are the values permitted for the type of a ServiceBinding. When set, ServiceBindings must set a permitted
+	// type, and the `type` entry of the binding, when present, must also be permitted. When empty, any type is permitted.
+	Types []string `json:"types,omitempty"`
+	// DeniedContainers are the names of containers that may not be bound. When set, ServiceBindings must name the
+	// containers to bind, as every container of the workload is bound otherwise.
+	DeniedContainers []string `json:"deniedContainers,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ServiceBindingPolicy is the Schema for the servicebindingpolicies API. The policy restricts the services and
+// workloads that ServiceBindings in the selected namespaces may bind. Every policy selecting the namespace of a
+// ServiceBinding must admit it. Policies are enforced when a ServiceBinding is created or updated, and existing
+// ServiceBindings that violate a policy are unprojected from their workload.
+type ServiceBindingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceBindingPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBindingPolicyList contains a list of ServiceBindingPolicy
+type ServiceBindingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceBindingPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceBindingPolicy{}, &ServiceBindingPolicyList{})
+}
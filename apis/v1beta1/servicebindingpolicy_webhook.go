@@ -0,0 +1,101 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *ServiceBindingPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-servicebindingpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=servicebindingpolicies,verbs=create;update,versions=v1beta1,name=vservicebindingpolicy.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ServiceBindingPolicy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingPolicy) ValidateCreate() error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingPolicy) ValidateUpdate(old runtime.Object) error {
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceBindingPolicy) ValidateDelete() error {
+	return nil
+}
+
+func (r *ServiceBindingPolicy) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
+
+func (r *ServiceBindingPolicySpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.NamespaceSelector); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("namespaceSelector"), r.NamespaceSelector, err.Error()))
+		}
+	}
+	for i := range r.Services {
+		errs = append(errs, r.Services[i].validate(fldPath.Child("services").Index(i))...)
+	}
+	for i := range r.Workloads {
+		errs = append(errs, r.Workloads[i].validate(fldPath.Child("workloads").Index(i))...)
+	}
+	types := map[string]int{}
+	for i, t := range r.Types {
+		if t == "" {
+			errs = append(errs, field.Required(fldPath.Child("types").Index(i), ""))
+			continue
+		}
+		if p, ok := types[t]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child("types", fmt.Sprintf("[%d, %d]", p, i)), t))
+		}
+		types[t] = i
+	}
+	for i, container := range r.DeniedContainers {
+		for _, msg := range validation.IsDNS1123Label(container) {
+			errs = append(errs, field.Invalid(fldPath.Child("deniedContainers").Index(i), container, msg))
+		}
+	}
+
+	return errs
+}
+
+func (r *ServiceBindingPolicyResource) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+
+	return errs
+}
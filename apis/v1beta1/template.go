@@ -0,0 +1,226 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+const (
+	// TemplateMaxOutputBytes is the most a template may render, the size limit of a Secret
+	TemplateMaxOutputBytes = 1024 * 1024
+	// TemplateTimeout is the longest a template may execute
+	TemplateTimeout = time.Second
+)
+
+var (
+	// ErrTemplateOutputTooLarge is returned when a template renders more than TemplateMaxOutputBytes
+	ErrTemplateOutputTooLarge = fmt.Errorf("template output exceeds %d bytes", TemplateMaxOutputBytes)
+	// ErrTemplateTimeout is returned when a template executes for longer than TemplateTimeout
+	ErrTemplateTimeout = fmt.Errorf("template execution exceeds %s", TemplateTimeout)
+)
+
+// ExecuteTemplate parses and executes a template authored by a user of the cluster. Only the built-in template
+// functions are available and a missing key is an error. The output is limited to TemplateMaxOutputBytes and the
+// execution to TemplateTimeout, so that a template can't exhaust the memory or CPU of the controller.
+func ExecuteTemplate(name, text string, data interface{}) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Funcs(limitedTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	// the deadline is checked as the template writes, every loop iteration and template call writes so that
+	// templates that don't render anything are interrupted too
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil && tt.Tree.Root != nil {
+			interruptible(tt.Tree.Root)
+		}
+	}
+
+	out := &limitedWriter{
+		limit:    TemplateMaxOutputBytes,
+		deadline: time.Now().Add(TemplateTimeout),
+	}
+	if err := t.Execute(out, data); err != nil {
+		// unwrap the cause from the ExecError, the template position is not helpful for a limit
+		if errors.Is(err, ErrTemplateOutputTooLarge) {
+			return nil, ErrTemplateOutputTooLarge
+		}
+		if errors.Is(err, ErrTemplateTimeout) {
+			return nil, ErrTemplateTimeout
+		}
+		return nil, err
+	}
+	return out.buf.Bytes(), nil
+}
+
+// interruptible prepends an empty text node to the list and to the body of each nested loop. Text nodes are always
+// written, even when empty.
+func interruptible(list *parse.ListNode) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.IfNode:
+			interruptible(n.List)
+			interruptible(n.ElseList)
+		case *parse.WithNode:
+			interruptible(n.List)
+			interruptible(n.ElseList)
+		case *parse.RangeNode:
+			interruptible(n.List)
+			interruptible(n.ElseList)
+		case *parse.ListNode:
+			interruptible(n)
+		}
+	}
+	list.Nodes = append([]parse.Node{&parse.TextNode{NodeType: parse.NodeText, Pos: list.Pos, Text: []byte{}}}, list.Nodes...)
+}
+
+// limitedWriter buffers the output of a template, failing writes beyond the limit or after the deadline
+type limitedWriter struct {
+	buf      bytes.Buffer
+	limit    int
+	deadline time.Time
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if time.Now().After(w.deadline) {
+		return 0, ErrTemplateTimeout
+	}
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, ErrTemplateOutputTooLarge
+	}
+	return w.buf.Write(p)
+}
+
+// limitedTemplateFuncs replaces the built-in functions that return strings larger than their arguments, so that
+// intermediate values are limited like the output
+var limitedTemplateFuncs = template.FuncMap{
+	"print": func(args ...interface{}) (string, error) {
+		if err := checkArgs(args); err != nil {
+			return "", err
+		}
+		return limitString(fmt.Sprint(args...))
+	},
+	"printf": func(format string, args ...interface{}) (string, error) {
+		if err := checkFormat(format, args); err != nil {
+			return "", err
+		}
+		return limitString(fmt.Sprintf(format, args...))
+	},
+	"println": func(args ...interface{}) (string, error) {
+		if err := checkArgs(args); err != nil {
+			return "", err
+		}
+		return limitString(fmt.Sprintln(args...))
+	},
+	"html": func(args ...interface{}) (string, error) {
+		if err := checkArgs(args); err != nil {
+			return "", err
+		}
+		return limitString(template.HTMLEscaper(args...))
+	},
+	"js": func(args ...interface{}) (string, error) {
+		if err := checkArgs(args); err != nil {
+			return "", err
+		}
+		return limitString(template.JSEscaper(args...))
+	},
+	"urlquery": func(args ...interface{}) (string, error) {
+		if err := checkArgs(args); err != nil {
+			return "", err
+		}
+		return limitString(template.URLQueryEscaper(args...))
+	},
+}
+
+func limitString(s string) (string, error) {
+	if len(s) > TemplateMaxOutputBytes {
+		return "", ErrTemplateOutputTooLarge
+	}
+	return s, nil
+}
+
+// checkArgs rejects arguments that are together larger than the output may be
+func checkArgs(args []interface{}) error {
+	size := 0
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case string:
+			size += len(a)
+		case []byte:
+			size += len(a)
+		}
+	}
+	if size > TemplateMaxOutputBytes {
+		return ErrTemplateOutputTooLarge
+	}
+	return nil
+}
+
+// checkFormat estimates the length of a formatted string before it is formatted. The padding of each verb, either a
+// width or precision in the format or an int argument for `*`, is limited by fmt, but a format may contain many verbs.
+func checkFormat(format string, args []interface{}) error {
+	size := len(format)
+	star := strings.Contains(format, "*")
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case string:
+			size += len(a)
+		case []byte:
+			size += len(a)
+		case int:
+			if star && a > 0 {
+				size += a
+			}
+		}
+	}
+	for i := 0; i < len(format) && size <= TemplateMaxOutputBytes; i++ {
+		if format[i] != '%' {
+			continue
+		}
+		// sum the numbers between the percent and the verb
+		n := 0
+		for i++; i < len(format); i++ {
+			c := format[i]
+			if c >= '0' && c <= '9' {
+				n = n*10 + int(c-'0')
+				if n > TemplateMaxOutputBytes {
+					return ErrTemplateOutputTooLarge
+				}
+				continue
+			}
+			size += n
+			n = 0
+			if c != '.' && c != '+' && c != '-' && c != '#' && c != ' ' && c != '[' && c != ']' && c != '*' {
+				break
+			}
+		}
+		size += n
+	}
+	if size > TemplateMaxOutputBytes {
+		return ErrTemplateOutputTooLarge
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExecuteTemplate(t *testing.T) {
+	data := map[string]string{
+		"host": "db.example.com",
+		"port": "5432",
+	}
+
+	tests := []struct {
+		name        string
+		text        string
+		expected    string
+		shouldErr   bool
+		expectedErr error
+	}{
+		{
+			name:     "render",
+			text:     "{{ .host }}:{{ .port }}",
+			expected: "db.example.com:5432",
+		},
+		{
+			name:     "render loops and functions",
+			text:     `{{ range $i := 3 }}{{ if $i }},{{ end }}{{ printf "%s-%d" $.host $i }}{{ end }}`,
+			expected: "db.example.com-0,db.example.com-1,db.example.com-2",
+		},
+		{
+			name:     "render at the limit",
+			text:     `{{ range 1024 }}{{ printf "%01024d" 0 }}{{ end }}`,
+			expected: strings.Repeat("0", TemplateMaxOutputBytes),
+		},
+		{
+			name:        "oversized output",
+			text:        "{{ range 20000000 }}aaaaaaaaaa{{ end }}",
+			shouldErr:   true,
+			expectedErr: ErrTemplateOutputTooLarge,
+		},
+		{
+			name:        "oversized intermediate value",
+			text:        `{{ $a := printf "%01000000d" 0 }}{{ $b := print $a $a }}{{ len $b }}`,
+			shouldErr:   true,
+			expectedErr: ErrTemplateOutputTooLarge,
+		},
+		{
+			name:        "oversized format",
+			text:        `{{ printf "%0900000d%0900000d" 0 0 }}`,
+			shouldErr:   true,
+			expectedErr: ErrTemplateOutputTooLarge,
+		},
+		{
+			name:        "oversized escaped value",
+			text:        `{{ $a := "<<<<<<<<" }}{{ $a = print $a $a $a $a $a $a $a $a }}{{ $a = print $a $a $a $a $a $a $a $a }}{{ $a = print $a $a $a $a $a $a $a $a }}{{ $a = print $a $a $a $a $a $a $a $a }}{{ $a = print $a $a $a $a $a $a $a $a }}{{ $a = print $a $a $a $a }}{{ len (html $a) }}`,
+			shouldErr:   true,
+			expectedErr: ErrTemplateOutputTooLarge,
+		},
+		{
+			name:        "nested loops without output",
+			text:        "{{ range 1000000 }}{{ range 1000000 }}{{ end }}{{ end }}",
+			shouldErr:   true,
+			expectedErr: ErrTemplateTimeout,
+		},
+		{
+			name:        "recursive templates without output",
+			text:        `{{ define "a" }}{{ if . }}{{ template "a" (slice . 1) }}{{ template "a" (slice . 1) }}{{ end }}{{ end }}{{ template "a" "0123456789012345678901234567890123456789" }}`,
+			shouldErr:   true,
+			expectedErr: ErrTemplateTimeout,
+		},
+		{
+			name:      "missing key",
+			text:      "{{ .database }}",
+			shouldErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ExecuteTemplate(c.name, c.text, data)
+			if (err != nil) != c.shouldErr {
+				t.Fatalf("ExecuteTemplate() expected error %v, actual %v", c.shouldErr, err)
+			}
+			if c.expectedErr != nil && !errors.Is(err, c.expectedErr) {
+				t.Fatalf("ExecuteTemplate() expected error %v, actual %v", c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, string(actual)); diff != "" {
+				t.Errorf("ExecuteTemplate() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
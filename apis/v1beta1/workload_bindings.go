@@ -0,0 +1,69 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// WorkloadBindingsAnnotation declares the bindings of a workload on the workload itself. The value is a JSON encoded
+// list of bindings, each binding is projected into the workload by a ServiceBinding owned by the workload.
+//
+//	servicebinding.io/bindings: '[{"service": {"apiVersion": "v1", "kind": "Secret", "name": "orders-db"}}]'
+const WorkloadBindingsAnnotation = "servicebinding.io/bindings"
+
+// ParseWorkloadBindings decodes and validates the bindings declared by the annotations of a workload. The name of a
+// binding defaults to the name of the service. Nil is returned when the annotation is not set.
+func ParseWorkloadBindings(annotations map[string]string) ([]BindingPolicyBinding, error) {
+	value, ok := annotations[WorkloadBindingsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	fldPath := field.NewPath("metadata", "annotations").Key(WorkloadBindingsAnnotation)
+
+	bindings := []BindingPolicyBinding{}
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&bindings); err != nil {
+		return nil, field.ErrorList{
+			field.Invalid(fldPath, value, fmt.Sprintf("must be a JSON list of bindings: %s", err)),
+		}.ToAggregate()
+	}
+
+	errs := field.ErrorList{}
+	names := map[string]int{}
+	for i := range bindings {
+		if bindings[i].Name == "" {
+			bindings[i].Name = bindings[i].Service.Name
+		}
+		// check for duplicate names
+		if p, ok := names[bindings[i].Name]; ok {
+			errs = append(errs, field.Duplicate(fldPath.Child(fmt.Sprintf("[%d, %d]", p, i), "name"), bindings[i].Name))
+		}
+		names[bindings[i].Name] = i
+		errs = append(errs, bindings[i].validate(fldPath.Index(i))...)
+	}
+	if len(errs) != 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return bindings, nil
+}
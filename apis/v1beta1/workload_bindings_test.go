@@ -0,0 +1,129 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestParseWorkloadBindings(t *testing.T) {
+	fldPath := field.NewPath("metadata", "annotations").Key(WorkloadBindingsAnnotation)
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    []BindingPolicyBinding
+		expectedErr error
+	}{
+		{
+			name: "no annotation",
+		},
+		{
+			name: "empty list",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: "[]",
+			},
+			expected: []BindingPolicyBinding{},
+		},
+		{
+			name: "bindings",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: `[
+					{"service": {"apiVersion": "v1", "kind": "Secret", "name": "orders-db"}},
+					{"name": "cache", "type": "redis", "service": {"apiVersion": "v1", "kind": "Secret", "name": "my-redis"}, "env": [{"name": "REDIS_HOST", "key": "host"}]}
+				]`,
+			},
+			expected: []BindingPolicyBinding{
+				{
+					Name: "orders-db",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "orders-db",
+					},
+				},
+				{
+					Name: "cache",
+					Type: "redis",
+					Service: ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-redis",
+					},
+					Env: []EnvMapping{
+						{Name: "REDIS_HOST", Key: "host"},
+					},
+				},
+			},
+		},
+		{
+			name: "malformed json",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: "orders-db",
+			},
+			expectedErr: field.ErrorList{
+				field.Invalid(fldPath, "orders-db", "must be a JSON list of bindings: invalid character 'o' looking for beginning of value"),
+			}.ToAggregate(),
+		},
+		{
+			name: "unknown field",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: `[{"serviceName": "orders-db"}]`,
+			},
+			expectedErr: field.ErrorList{
+				field.Invalid(fldPath, `[{"serviceName": "orders-db"}]`, `must be a JSON list of bindings: json: unknown field "serviceName"`),
+			}.ToAggregate(),
+		},
+		{
+			name: "invalid binding",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: `[{"service": {"name": "orders-db"}}]`,
+			},
+			expectedErr: field.ErrorList{
+				field.Required(fldPath.Index(0).Child("service", "apiVersion"), ""),
+				field.Required(fldPath.Index(0).Child("service", "kind"), ""),
+			}.ToAggregate(),
+		},
+		{
+			name: "duplicate binding name",
+			annotations: map[string]string{
+				WorkloadBindingsAnnotation: `[
+					{"service": {"apiVersion": "v1", "kind": "Secret", "name": "orders-db"}},
+					{"name": "orders-db", "service": {"apiVersion": "v1", "kind": "Secret", "name": "my-orders-db"}}
+				]`,
+			},
+			expectedErr: field.ErrorList{
+				field.Duplicate(fldPath.Child("[0, 1]", "name"), "orders-db"),
+			}.ToAggregate(),
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ParseWorkloadBindings(c.annotations)
+			if diff := cmp.Diff(c.expectedErr, err); diff != "" {
+				t.Errorf("ParseWorkloadBindings() error (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ParseWorkloadBindings() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestWorkloadResourceMappingDefault(t *testing.T) {
+	seed := &WorkloadResourceMapping{
+		Spec: ClusterWorkloadResourceMappingSpec{
+			Versions: []ClusterWorkloadResourceMappingTemplate{
+				{
+					Version: "*",
+				},
+			},
+		},
+	}
+	expected := seed.DeepCopy()
+	expected.Spec.Versions[0].Default()
+
+	actual := seed.DeepCopy()
+	actual.Default()
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("Default (-expected, +actual): %s", diff)
+	}
+}
+
+func TestWorkloadResourceMappingValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *WorkloadResourceMapping
+		expected field.ErrorList
+	}{
+		{
+			name:     "empty is valid",
+			seed:     &WorkloadResourceMapping{},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "version patterns are valid",
+			seed: &WorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "v1",
+						},
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "duplicate version is invalid",
+			seed: &WorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+						},
+						{
+							Version: "*",
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("spec.versions.[0, 1].version"), "*"),
+			},
+		},
+		{
+			name: "invalid container path",
+			seed: &WorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version: "*",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path: "}{",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec.versions[0].containers[0].path"), "}{", "too many root nodes"),
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, c.seed.validate()); diff != "" {
+				t.Errorf("validate (-expected, +actual): %s", diff)
+			}
+
+			expectedErr := c.expected.ToAggregate()
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateCreate()); diff != "" {
+				t.Errorf("ValidateCreate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(expectedErr, c.seed.ValidateUpdate(c.seed.DeepCopy())); diff != "" {
+				t.Errorf("ValidateUpdate (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(nil, c.seed.ValidateDelete()); diff != "" {
+				t.Errorf("ValidateDelete (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *WorkloadResourceMapping) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+var _ webhook.Defaulter = &WorkloadResourceMapping{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *WorkloadResourceMapping) Default() {
+	r.Spec.Default()
+}
+
+//+kubebuilder:webhook:path=/validate-servicebinding-io-v1beta1-workloadresourcemapping,mutating=false,failurePolicy=fail,sideEffects=None,groups=servicebinding.io,resources=workloadresourcemappings,verbs=create;update,versions=v1beta1,name=vworkloadresourcemapping.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &WorkloadResourceMapping{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *WorkloadResourceMapping) ValidateCreate() error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *WorkloadResourceMapping) ValidateUpdate(old runtime.Object) error {
+	r.Default()
+	return r.validate().ToAggregate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *WorkloadResourceMapping) ValidateDelete() error {
+	return nil
+}
+
+func (r *WorkloadResourceMapping) validate() field.ErrorList {
+	return r.Spec.validate(field.NewPath("spec"))
+}
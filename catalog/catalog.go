@@ -0,0 +1,108 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"sort"
+
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// Version of the catalog, bumped when a mapping is added, changed or removed
+const Version = "1"
+
+// VersionAnnotation records the version of the catalog on each mapping from the catalog
+const VersionAnnotation = "servicebinding.io/catalog-version"
+
+//go:embed mappings/*.yaml
+var files embed.FS
+
+var mappings = load()
+
+// Mappings returns every mapping in the catalog, ordered by name
+func Mappings() []servicebindingv1beta1.ClusterWorkloadResourceMapping {
+	out := make([]servicebindingv1beta1.ClusterWorkloadResourceMapping, len(mappings))
+	for i := range mappings {
+		mappings[i].DeepCopyInto(&out[i])
+	}
+	return out
+}
+
+// Lookup returns the mapping in the catalog for the `{resource}.{group}` of a workload resource, or nil if the
+// resource is not in the catalog
+func Lookup(name string) *servicebindingv1beta1.ClusterWorkloadResourceMapping {
+	i := sort.Search(len(mappings), func(i int) bool {
+		return mappings[i].Name >= name
+	})
+	if i == len(mappings) || mappings[i].Name != name {
+		return nil
+	}
+	return mappings[i].DeepCopy()
+}
+
+func load() []servicebindingv1beta1.ClusterWorkloadResourceMapping {
+	entries, err := files.ReadDir("mappings")
+	if err != nil {
+		panic(err)
+	}
+	out := []servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+	for _, entry := range entries {
+		b, err := files.ReadFile("mappings/" + entry.Name())
+		if err != nil {
+			panic(err)
+		}
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+		for {
+			doc, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				panic(fmt.Errorf("invalid catalog file %q: %w", entry.Name(), err))
+			}
+			doc, err = yaml.YAMLToJSON(doc)
+			if err != nil {
+				panic(fmt.Errorf("invalid catalog file %q: %w", entry.Name(), err))
+			}
+			if string(doc) == "null" {
+				// comment only document
+				continue
+			}
+			mapping := servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+			if err := yaml.UnmarshalStrict(doc, &mapping); err != nil {
+				panic(fmt.Errorf("invalid catalog file %q: %w", entry.Name(), err))
+			}
+			if mapping.Annotations == nil {
+				mapping.Annotations = map[string]string{}
+			}
+			mapping.Annotations[VersionAnnotation] = Version
+			out = append(out, mapping)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestMappings(t *testing.T) {
+	mappings := Mappings()
+	if len(mappings) == 0 {
+		t.Fatalf("expected catalog to contain mappings")
+	}
+	seen := map[string]bool{}
+	for i := range mappings {
+		mapping := &mappings[i]
+		t.Run(mapping.Name, func(t *testing.T) {
+			if seen[mapping.Name] {
+				t.Errorf("duplicate mapping %q", mapping.Name)
+			}
+			seen[mapping.Name] = true
+			if gr := schema.ParseGroupResource(mapping.Name); gr.Group == "" || gr.Resource == "" {
+				t.Errorf("expected name to be `{resource}.{group}`, got %q", mapping.Name)
+			}
+			if expected, actual := "ClusterWorkloadResourceMapping", mapping.Kind; expected != actual {
+				t.Errorf("expected kind %q, got %q", expected, actual)
+			}
+			if expected, actual := Version, mapping.Annotations[VersionAnnotation]; expected != actual {
+				t.Errorf("expected catalog version %q, got %q", expected, actual)
+			}
+			if err := mapping.DeepCopy().ValidateCreate(); err != nil {
+				t.Errorf("invalid mapping: %s", err)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{
+		"cronjobs.batch",
+		"services.serving.knative.dev",
+		"configurations.serving.knative.dev",
+		"rollouts.argoproj.io",
+		"clonesets.apps.kruise.io",
+		"scaledjobs.keda.sh",
+	} {
+		t.Run(name, func(t *testing.T) {
+			mapping := Lookup(name)
+			if mapping == nil {
+				t.Fatalf("expected mapping for %q", name)
+			}
+			if diff := cmp.Diff(name, mapping.Name); diff != "" {
+				t.Errorf("Lookup() (-expected, +actual): %s", diff)
+			}
+			// mutations must not leak into the catalog
+			mapping.Spec.Versions = nil
+			if len(Lookup(name).Spec.Versions) == 0 {
+				t.Errorf("expected Lookup() to return a copy")
+			}
+		})
+	}
+
+	if mapping := Lookup("deployments.apps"); mapping != nil {
+		t.Errorf("expected no mapping for PodSpecable resource, got %v", mapping)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// diagnose-rbac scans the ServiceBindings and ClusterWorkloadResourceMappings on the cluster, checks the controller is
+// permitted to use each service and workload resource, and prints a ClusterRole granting the missing permissions. The
+// ClusterRole is aggregated into the role of the controller once applied. Checks are made by impersonating the service
+// account of the controller, the caller must be permitted to impersonate it.
+//
+//	diagnose-rbac
+//	diagnose-rbac --as system:serviceaccount:my-namespace:my-controller | kubectl apply -f -
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/rbac"
+)
+
+func main() {
+	as := flag.String("as", "system:serviceaccount:servicebinding-runtime-system:servicebinding-runtime-controller-manager", "user to check permissions for, the service account of the controller")
+	name := flag.String("name", "servicebinding-runtime-diagnosed-role", "name of the printed ClusterRole")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [--as user] [--name role]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+	cfg.Impersonate.UserName = *as
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := run(context.Background(), c, *name, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, c client.Client, name string, stdout, stderr io.Writer) error {
+	diagnosis, err := rbac.Diagnose(ctx, c, rbac.NewAccessChecker(c, 0))
+	if err != nil {
+		return err
+	}
+	for _, note := range diagnosis.Notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+	if len(diagnosis.Rules) == 0 {
+		fmt.Fprintf(stderr, "note: the controller has the permissions it needs\n")
+		return nil
+	}
+
+	// drop zero values that would otherwise be rendered, like metadata.creationTimestamp
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rbac.AggregatedClusterRole(name, diagnosis.Rules))
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+	out, err := yaml.Marshal(u)
+	if err != nil {
+		return err
+	}
+	_, err = stdout.Write(out)
+	return err
+}
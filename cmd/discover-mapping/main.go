@@ -0,0 +1,84 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// discover-mapping reads a CustomResourceDefinition and prints a candidate ClusterWorkloadResourceMapping for it.
+//
+//	discover-mapping crd.yaml
+//	kubectl get crd my-workloads.example.com -o yaml | discover-mapping -
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/scothis/servicebinding-runtime/discovery"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintf(os.Stderr, "usage: %s <crd.yaml|->\n", os.Args[0])
+		os.Exit(2)
+	}
+	if err := run(os.Args[1], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(file string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var in []byte
+	var err error
+	if file == "-" {
+		in, err = io.ReadAll(stdin)
+	} else {
+		in, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.UnmarshalStrict(in, crd); err != nil {
+		return err
+	}
+
+	candidate, err := discovery.MappingForCRD(crd)
+	for _, note := range candidate.Notes {
+		fmt.Fprintf(stderr, "note: %s\n", note)
+	}
+	if err != nil {
+		return err
+	}
+
+	// drop zero values that would otherwise be rendered, like metadata.creationTimestamp
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(candidate.Mapping)
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+	out, err := yaml.Marshal(u)
+	if err != nil {
+		return err
+	}
+	_, err = stdout.Write(out)
+	return err
+}
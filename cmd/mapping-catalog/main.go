@@ -0,0 +1,99 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// mapping-catalog lists the workload resource mappings built into the controller. Mappings defined on the cluster as a
+// ClusterWorkloadResourceMapping or WorkloadResourceMapping take precedence over the catalog.
+//
+//	mapping-catalog
+//	mapping-catalog -o yaml cronjobs.batch
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/catalog"
+)
+
+func main() {
+	output := flag.String("o", "", "output format, one of: yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-o yaml] [name...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(*output, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(output string, names []string, stdout io.Writer) error {
+	mappings := []servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+	if len(names) == 0 {
+		mappings = catalog.Mappings()
+	}
+	for _, name := range names {
+		mapping := catalog.Lookup(name)
+		if mapping == nil {
+			return fmt.Errorf("mapping %q is not in the catalog", name)
+		}
+		mappings = append(mappings, *mapping)
+	}
+
+	switch output {
+	case "":
+		w := tabwriter.NewWriter(stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintf(w, "NAME\tVERSIONS\tCATALOG\n")
+		for _, mapping := range mappings {
+			versions := []string{}
+			for _, version := range mapping.Spec.Versions {
+				versions = append(versions, version.Version)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", mapping.Name, strings.Join(versions, ","), mapping.Annotations[catalog.VersionAnnotation])
+		}
+		return w.Flush()
+	case "yaml":
+		for _, mapping := range mappings {
+			// drop zero values that would otherwise be rendered, like metadata.creationTimestamp and status
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&mapping)
+			if err != nil {
+				return err
+			}
+			unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+			unstructured.RemoveNestedField(u, "status")
+			out, err := yaml.Marshal(u)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(stdout, "---\n%s", out); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q", output)
+	}
+}
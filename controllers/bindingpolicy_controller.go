@@ -0,0 +1,299 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware-labs/reconciler-runtime/apis"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/resolver"
+)
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=bindingpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=bindingpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=bindingpolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// BindingPolicyReconciler reconciles a BindingPolicy object. A ServiceBinding is generated for each binding and
+// workload selector of the policy, the ServiceBinding reconciler and admission projector then bind existing and new
+// workloads as they would for any other ServiceBinding.
+func BindingPolicyReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler {
+	return &reconcilers.ResourceReconciler{
+		Type: &servicebindingv1beta1.BindingPolicy{},
+		Reconciler: reconcilers.Sequence{
+			ManagePolicyServiceBindings(),
+			ResolvePolicyWorkloads(),
+		},
+
+		Config: c,
+	}
+}
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings,verbs=get;list;watch;create;update;patch;delete
+
+func ManagePolicyServiceBindings() reconcilers.SubReconciler {
+	manager := newServiceBindingManager("PolicyServiceBindingManager")
+
+	return &reconcilers.SyncReconciler{
+		Name: "ManagePolicyServiceBindings",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.BindingPolicy) error {
+			serviceBindings, err := manageControlledServiceBindings(ctx, manager, resource, desiredPolicyServiceBindings(resource))
+			if err != nil {
+				return err
+			}
+			resource.Status.ServiceBindings = make([]servicebindingv1beta1.BindingPolicyServiceBindingStatus, len(serviceBindings))
+			for i := range serviceBindings {
+				resource.Status.ServiceBindings[i] = policyServiceBindingStatus(serviceBindings[i])
+			}
+
+			var notReady *servicebindingv1beta1.BindingPolicyServiceBindingStatus
+			for i := range resource.Status.ServiceBindings {
+				status := &resource.Status.ServiceBindings[i]
+				if status.Ready == metav1.ConditionFalse {
+					notReady = status
+					break
+				}
+				if status.Ready != metav1.ConditionTrue && notReady == nil {
+					notReady = status
+				}
+			}
+			switch {
+			case notReady == nil:
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.BindingPolicyConditionServiceBindingsReady, "ServiceBindingsReady", "")
+			case notReady.Ready == metav1.ConditionFalse:
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.BindingPolicyConditionServiceBindingsReady, "ServiceBindingNotReady", "the ServiceBinding %q is not ready: %s", notReady.Name, notReady.Reason)
+			default:
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.BindingPolicyConditionServiceBindingsReady, "ServiceBindingNotReady", "the ServiceBinding %q is not ready", notReady.Name)
+			}
+
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			bldr.Owns(&servicebindingv1beta1.ServiceBinding{})
+			return manager.Setup(ctx)
+		},
+	}
+}
+
+func newServiceBindingManager(name string) *reconcilers.ResourceManager {
+	return &reconcilers.ResourceManager{
+		Name: name,
+		Type: &servicebindingv1beta1.ServiceBinding{},
+		MergeBeforeUpdate: func(current, desired *servicebindingv1beta1.ServiceBinding) {
+			current.Labels = desired.Labels
+			current.Spec = desired.Spec
+		},
+		SemanticEquals: func(a1, a2 *servicebindingv1beta1.ServiceBinding) bool {
+			return equality.Semantic.DeepEqual(a1.Labels, a2.Labels) &&
+				equality.Semantic.DeepEqual(a1.Spec, a2.Spec)
+		},
+		Sanitize: func(child *servicebindingv1beta1.ServiceBinding) servicebindingv1beta1.ServiceBindingSpec {
+			return child.Spec
+		},
+	}
+}
+
+// manageControlledServiceBindings creates or updates the desired ServiceBindings and deletes the other ServiceBindings
+// controlled by the owner. The managed ServiceBindings are returned in the order they are desired.
+func manageControlledServiceBindings(ctx context.Context, manager *reconcilers.ResourceManager, owner client.Object, desired []*servicebindingv1beta1.ServiceBinding) ([]*servicebindingv1beta1.ServiceBinding, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+	if err := c.List(ctx, serviceBindings, client.InNamespace(owner.GetNamespace())); err != nil {
+		return nil, err
+	}
+	actual := map[string]*servicebindingv1beta1.ServiceBinding{}
+	for i := range serviceBindings.Items {
+		if metav1.IsControlledBy(&serviceBindings.Items[i], owner) {
+			actual[serviceBindings.Items[i].Name] = &serviceBindings.Items[i]
+		}
+	}
+
+	managed := make([]*servicebindingv1beta1.ServiceBinding, len(desired))
+	for i := range desired {
+		current, ok := actual[desired[i].Name]
+		if !ok {
+			current = &servicebindingv1beta1.ServiceBinding{}
+		}
+		delete(actual, desired[i].Name)
+		serviceBinding, err := manager.Manage(ctx, owner, current, desired[i])
+		if err != nil {
+			return nil, err
+		}
+		managed[i] = serviceBinding.(*servicebindingv1beta1.ServiceBinding)
+	}
+
+	// delete ServiceBindings that are no longer desired
+	unwanted := make([]string, 0, len(actual))
+	for name := range actual {
+		unwanted = append(unwanted, name)
+	}
+	sort.Strings(unwanted)
+	for _, name := range unwanted {
+		if _, err := manager.Manage(ctx, owner, actual[name], nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return managed, nil
+}
+
+// desiredPolicyServiceBindings generates a ServiceBinding for each binding and workload selector of the policy. The
+// name of the ServiceBinding is stable for as long as the order of the workload selectors is.
+func desiredPolicyServiceBindings(resource *servicebindingv1beta1.BindingPolicy) []*servicebindingv1beta1.ServiceBinding {
+	serviceBindings := []*servicebindingv1beta1.ServiceBinding{}
+	for i := range resource.Spec.Workloads {
+		workload := resource.Spec.Workloads[i].DeepCopy()
+		for j := range resource.Spec.Bindings {
+			binding := resource.Spec.Bindings[j].DeepCopy()
+			serviceBindings = append(serviceBindings, &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: resource.Namespace,
+					Name:      policyServiceBindingName(resource, binding.Name, i),
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(resource, servicebindingv1beta1.GroupVersion.WithKind("BindingPolicy")),
+					},
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name:     binding.Name,
+					Type:     binding.Type,
+					Provider: binding.Provider,
+					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+						APIVersion: workload.APIVersion,
+						Kind:       workload.Kind,
+						Selector:   &workload.Selector,
+						Containers: workload.Containers,
+					},
+					Service: binding.Service,
+					Env:     binding.Env,
+				},
+			})
+		}
+	}
+	return serviceBindings
+}
+
+// policyServiceBindingName is unique for each binding and workload selector of the policy. Joining the names of the
+// policy and binding is ambiguous, as either may contain a dash, so a hash of the parts is appended. The name is
+// truncated to fit within the limit for an object name.
+func policyServiceBindingName(resource *servicebindingv1beta1.BindingPolicy, binding string, workload int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d", resource.Name, binding, workload)))
+	suffix := fmt.Sprintf("-%x", hash[:4])
+	name := fmt.Sprintf("%s-%s-%d", resource.Name, binding, workload)
+	if max := validation.DNS1123SubdomainMaxLength - len(suffix); len(name) > max {
+		name = strings.TrimRight(name[:max], "-.")
+	}
+	return name + suffix
+}
+
+func policyServiceBindingStatus(serviceBinding *servicebindingv1beta1.ServiceBinding) servicebindingv1beta1.BindingPolicyServiceBindingStatus {
+	status := servicebindingv1beta1.BindingPolicyServiceBindingStatus{
+		Name:    serviceBinding.Name,
+		Binding: serviceBinding.Spec.Name,
+		Ready:   metav1.ConditionUnknown,
+	}
+	// the Ready condition is stale until the ServiceBinding reconciler observes the current generation
+	if serviceBinding.Status.ObservedGeneration != serviceBinding.Generation {
+		return status
+	}
+	if ready := serviceBinding.Status.GetCondition(apis.ConditionReady); ready != nil {
+		status.Ready = ready.Status
+		status.Reason = ready.Reason
+	}
+	return status
+}
+
+func ResolvePolicyWorkloads() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolvePolicyWorkloads",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.BindingPolicy) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			r := resolver.New(c)
+
+			serviceBindings := map[string]servicebindingv1beta1.BindingPolicyServiceBindingStatus{}
+			for _, status := range resource.Status.ServiceBindings {
+				serviceBindings[status.Name] = status
+			}
+
+			resource.Status.Workloads = []servicebindingv1beta1.BindingPolicyWorkloadStatus{}
+			// a workload matched by multiple selectors is reported once
+			index := map[corev1.ObjectReference]int{}
+			for i, selector := range resource.Spec.Workloads {
+				ref := corev1.ObjectReference{
+					APIVersion: selector.APIVersion,
+					Kind:       selector.Kind,
+					Namespace:  resource.Namespace,
+				}
+				workloads, err := r.LookupWorkloads(ctx, ref, &selector.Selector)
+				if err != nil {
+					if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) || meta.IsNoMatchError(err) {
+						// the generated ServiceBindings report why the workloads are not resolved
+						continue
+					}
+					return err
+				}
+				for _, workload := range workloads {
+					workloadMeta, err := meta.Accessor(workload)
+					if err != nil {
+						return err
+					}
+					ref.Name = workloadMeta.GetName()
+					if _, ok := index[ref]; !ok {
+						index[ref] = len(resource.Status.Workloads)
+						resource.Status.Workloads = append(resource.Status.Workloads, servicebindingv1beta1.BindingPolicyWorkloadStatus{
+							APIVersion: ref.APIVersion,
+							Kind:       ref.Kind,
+							Name:       ref.Name,
+							Ready:      metav1.ConditionTrue,
+						})
+					}
+					status := &resource.Status.Workloads[index[ref]]
+					for _, binding := range resource.Spec.Bindings {
+						ready := serviceBindings[policyServiceBindingName(resource, binding.Name, i)].Ready
+						if ready == metav1.ConditionTrue {
+							continue
+						}
+						status.NotReadyBindings = append(status.NotReadyBindings, binding.Name)
+						if ready == metav1.ConditionFalse || status.Ready == metav1.ConditionFalse {
+							status.Ready = metav1.ConditionFalse
+						} else {
+							status.Ready = metav1.ConditionUnknown
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
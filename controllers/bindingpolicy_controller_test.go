@@ -0,0 +1,467 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	dieappsv1 "dies.dev/apis/apps/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/controllers"
+	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+)
+
+func TestManagePolicyServiceBindings(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-policy"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	policy := dieservicebindingv1beta1.BindingPolicyBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+	policyWithBinding := policy.
+		SpecDie(func(d *dieservicebindingv1beta1.BindingPolicySpecDie) {
+			d.WorkloadsDie(
+				dieservicebindingv1beta1.BindingPolicyWorkloadSelectorBlank.
+					APIVersion("apps/v1").
+					Kind("Deployment").
+					SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+						d.AddMatchLabel("tier", "backend")
+					}),
+			)
+			d.BindingsDie(
+				dieservicebindingv1beta1.BindingPolicyBindingBlank.
+					Name("tracing").
+					Type("zipkin").
+					ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+						d.APIVersion("v1")
+						d.Kind("Secret")
+						d.Name("tracing")
+					}),
+			)
+		})
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-policy-tracing-0-e03e4b84")
+			d.ControlledBy(policy, scheme)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Name("tracing")
+			d.Type("zipkin")
+			d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+					d.AddMatchLabel("tier", "backend")
+				})
+			})
+			d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("v1")
+				d.Kind("Secret")
+				d.Name("tracing")
+			})
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"policy without bindings": {
+			Resource: policy,
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							True().Reason("PolicyApplied"),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							True().Reason("ServiceBindingsReady"),
+					)
+					d.ServiceBindingsDie()
+				}),
+		},
+		"create service binding": {
+			Resource: policyWithBinding,
+			ExpectResource: policyWithBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+					)
+					d.ServiceBindingsDie(
+						dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+							Name("my-policy-tracing-0-e03e4b84").
+							Binding("tracing").
+							Ready(metav1.ConditionUnknown),
+					)
+				}),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(policy, scheme, corev1.EventTypeNormal, "Created", "Created ServiceBinding %q", "my-policy-tracing-0-e03e4b84"),
+			},
+			ExpectCreates: []client.Object{
+				serviceBinding,
+			},
+		},
+		"service binding ready": {
+			Resource: policyWithBinding,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ServiceBindingConditionReady.
+								True().Reason("ServiceBound"),
+						)
+					}),
+			},
+			ExpectResource: policyWithBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							True().Reason("PolicyApplied"),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							True().Reason("ServiceBindingsReady"),
+					)
+					d.ServiceBindingsDie(
+						dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+							Name("my-policy-tracing-0-e03e4b84").
+							Binding("tracing").
+							Ready(metav1.ConditionTrue).
+							Reason("ServiceBound"),
+					)
+				}),
+		},
+		"service binding failed": {
+			Resource: policyWithBinding,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ServiceBindingConditionReady.
+								False().Reason("WorkloadForbidden"),
+						)
+					}),
+			},
+			ExpectResource: policyWithBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							False().
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready: WorkloadForbidden`),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							False().
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready: WorkloadForbidden`),
+					)
+					d.ServiceBindingsDie(
+						dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+							Name("my-policy-tracing-0-e03e4b84").
+							Binding("tracing").
+							Ready(metav1.ConditionFalse).
+							Reason("WorkloadForbidden"),
+					)
+				}),
+		},
+		"update service binding": {
+			Resource: policyWithBinding,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Type("jaeger")
+					}),
+			},
+			ExpectResource: policyWithBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+					)
+					d.ServiceBindingsDie(
+						dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+							Name("my-policy-tracing-0-e03e4b84").
+							Binding("tracing").
+							Ready(metav1.ConditionUnknown),
+					)
+				}),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(policy, scheme, corev1.EventTypeNormal, "Updated", "Updated ServiceBinding %q", "my-policy-tracing-0-e03e4b84"),
+			},
+			ExpectUpdates: []client.Object{
+				serviceBinding,
+			},
+		},
+		"delete service binding for removed binding": {
+			Resource: policy,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							True().Reason("PolicyApplied"),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							True().Reason("ServiceBindingsReady"),
+					)
+					d.ServiceBindingsDie()
+				}),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(policy, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBinding %q", "my-policy-tracing-0-e03e4b84"),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(serviceBinding, scheme),
+			},
+		},
+		"distinct names for ambiguous policy and binding names": {
+			Resource: policyWithBinding,
+			GivenObjects: []client.Object{
+				// policy "my" with binding "policy-tracing" joins to the same prefix
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-policy-tracing-0-8912ede6")
+						d.ControlledBy(policy.
+							MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.Name("my")
+								d.UID("other-policy-uid")
+							}), scheme)
+					}).
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Name("policy-tracing")
+					}),
+			},
+			ExpectResource: policyWithBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							Reason("ServiceBindingNotReady").
+							Message(`the ServiceBinding "my-policy-tracing-0-e03e4b84" is not ready`),
+					)
+					d.ServiceBindingsDie(
+						dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+							Name("my-policy-tracing-0-e03e4b84").
+							Binding("tracing").
+							Ready(metav1.ConditionUnknown),
+					)
+				}),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(policy, scheme, corev1.EventTypeNormal, "Created", "Created ServiceBinding %q", "my-policy-tracing-0-e03e4b84"),
+			},
+			ExpectCreates: []client.Object{
+				serviceBinding,
+			},
+		},
+		"ignore service bindings not controlled by the policy": {
+			Resource: policy,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.OwnerReferences()
+					}),
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.BindingPolicyConditionReady.
+							True().Reason("PolicyApplied"),
+						dieservicebindingv1beta1.BindingPolicyConditionServiceBindingsReady.
+							True().Reason("ServiceBindingsReady"),
+					)
+					d.ServiceBindingsDie()
+				}),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ManagePolicyServiceBindings()
+	})
+}
+
+func TestResolvePolicyWorkloads(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-policy"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	policy := dieservicebindingv1beta1.BindingPolicyBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.BindingPolicySpecDie) {
+			d.WorkloadsDie(
+				dieservicebindingv1beta1.BindingPolicyWorkloadSelectorBlank.
+					APIVersion("apps/v1").
+					Kind("Deployment").
+					SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+						d.AddMatchLabel("tier", "backend")
+					}),
+			)
+			d.BindingsDie(
+				dieservicebindingv1beta1.BindingPolicyBindingBlank.
+					Name("tracing"),
+				dieservicebindingv1beta1.BindingPolicyBindingBlank.
+					Name("telemetry"),
+			)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment")
+	backendWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-backend")
+			d.AddLabel("tier", "backend")
+		})
+	frontendWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-frontend")
+			d.AddLabel("tier", "frontend")
+		})
+
+	serviceBindingStatus := func(binding string, ready metav1.ConditionStatus) *dieservicebindingv1beta1.BindingPolicyServiceBindingStatusDie {
+		return dieservicebindingv1beta1.BindingPolicyServiceBindingStatusBlank.
+			Name("my-policy-" + binding + "-0").
+			Binding(binding).
+			Ready(ready)
+	}
+
+	rts := rtesting.SubReconcilerTests{
+		"no matching workloads": {
+			Resource: policy,
+			GivenObjects: []client.Object{
+				frontendWorkload,
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.WorkloadsDie()
+				}),
+		},
+		"workload ready": {
+			Resource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionTrue),
+						serviceBindingStatus("telemetry", metav1.ConditionTrue),
+					)
+				}),
+			GivenObjects: []client.Object{
+				backendWorkload,
+				frontendWorkload,
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionTrue),
+						serviceBindingStatus("telemetry", metav1.ConditionTrue),
+					)
+					d.WorkloadsDie(
+						dieservicebindingv1beta1.BindingPolicyWorkloadStatusBlank.
+							APIVersion("apps/v1").
+							Kind("Deployment").
+							Name("my-backend").
+							Ready(metav1.ConditionTrue),
+					)
+				}),
+		},
+		"workload pending": {
+			Resource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionTrue),
+						serviceBindingStatus("telemetry", metav1.ConditionUnknown),
+					)
+				}),
+			GivenObjects: []client.Object{
+				backendWorkload,
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionTrue),
+						serviceBindingStatus("telemetry", metav1.ConditionUnknown),
+					)
+					d.WorkloadsDie(
+						dieservicebindingv1beta1.BindingPolicyWorkloadStatusBlank.
+							APIVersion("apps/v1").
+							Kind("Deployment").
+							Name("my-backend").
+							Ready(metav1.ConditionUnknown).
+							NotReadyBindings("telemetry"),
+					)
+				}),
+		},
+		"workload failed": {
+			Resource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionFalse),
+						serviceBindingStatus("telemetry", metav1.ConditionUnknown),
+					)
+				}),
+			GivenObjects: []client.Object{
+				backendWorkload,
+			},
+			ExpectResource: policy.
+				StatusDie(func(d *dieservicebindingv1beta1.BindingPolicyStatusDie) {
+					d.ServiceBindingsDie(
+						serviceBindingStatus("tracing", metav1.ConditionFalse),
+						serviceBindingStatus("telemetry", metav1.ConditionUnknown),
+					)
+					d.WorkloadsDie(
+						dieservicebindingv1beta1.BindingPolicyWorkloadStatusBlank.
+							APIVersion("apps/v1").
+							Kind("Deployment").
+							Name("my-backend").
+							Ready(metav1.ConditionFalse).
+							NotReadyBindings("tracing", "telemetry"),
+					)
+				}),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolvePolicyWorkloads()
+	})
+}
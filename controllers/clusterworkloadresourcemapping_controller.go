@@ -0,0 +1,286 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/discovery"
+	"github.com/scothis/servicebinding-runtime/rbac"
+)
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// ClusterWorkloadResourceMappingReconciler reconciles a ClusterWorkloadResourceMapping object
+func ClusterWorkloadResourceMappingReconciler(c reconcilers.Config, accessChecker rbac.AccessChecker) *reconcilers.ResourceReconciler {
+	return &reconcilers.ResourceReconciler{
+		Type: &servicebindingv1beta1.ClusterWorkloadResourceMapping{},
+		Reconciler: reconcilers.Sequence{
+			ResolveMappingResource(),
+			ResolveMappingPaths(),
+			CountMappingServiceBindings(),
+			CheckMappingPermissions(accessChecker),
+		},
+
+		Config: c,
+	}
+}
+
+func ResolveMappingResource() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolveMappingResource",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterWorkloadResourceMapping) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			gr := schema.ParseGroupResource(resource.Name)
+			gvrs, err := c.RESTMapper().ResourcesFor(gr.WithVersion(""))
+			if err != nil && !meta.IsNoMatchError(err) {
+				return err
+			}
+			served := sets.NewString()
+			for _, gvr := range gvrs {
+				// the mapper is lenient with singular resources and partial groups, mappings are looked up by exact name
+				if gvr.GroupResource() == gr {
+					served.Insert(gvr.Version)
+				}
+			}
+
+			resource.Status.Versions = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatus, len(resource.Spec.Versions))
+			unserved := []string{}
+			for i, version := range resource.Spec.Versions {
+				isServed := false
+				for _, v := range served.List() {
+					isServed = isServed || version.MatchesVersion(v)
+				}
+				resource.Status.Versions[i] = servicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatus{
+					Version: version.Version,
+					Served:  isServed,
+				}
+				if !isServed {
+					unserved = append(unserved, version.Version)
+				}
+			}
+
+			if served.Len() == 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved, "ResourceNotFound", "the resource %q is not known to the cluster", gr.String())
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed, "ResourceNotFound", "")
+				return nil
+			}
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved, "ResourceResolved", "")
+			if len(unserved) != 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed, "VersionNotServed", "versions are not served for the resource: %s", strings.Join(unserved, ", "))
+			} else {
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed, "VersionsServed", "")
+			}
+
+			return nil
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+
+func ResolveMappingPaths() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolveMappingPaths",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterWorkloadResourceMapping) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if cond := resource.Status.GetCondition(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved); cond == nil || cond.Status != metav1.ConditionTrue {
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved, "ResourceNotFound", "")
+				return nil
+			}
+
+			// the name of a CustomResourceDefinition is the same `{resource}.{group}` as the mapping
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := c.Get(ctx, types.NamespacedName{Name: resource.Name}, crd); err != nil {
+				if !apierrs.IsNotFound(err) {
+					return err
+				}
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved, "SchemaUnavailable", "the resource is not defined by a CustomResourceDefinition, paths were not checked")
+				return nil
+			}
+
+			unresolvedVersions := []string{}
+			for i := range resource.Spec.Versions {
+				template := &resource.Spec.Versions[i]
+				status := &resource.Status.Versions[i]
+				unresolved := sets.NewString()
+				for _, crdVersion := range crd.Spec.Versions {
+					if !crdVersion.Served || crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+						continue
+					}
+					if resource.Spec.LookupVersion(crdVersion.Name) != template {
+						continue
+					}
+					status.PathsChecked = true
+					unresolved.Insert(discovery.UnresolvedPaths(template, crdVersion.Schema.OpenAPIV3Schema)...)
+				}
+				if unresolved.Len() != 0 {
+					status.UnresolvedPaths = unresolved.List()
+					unresolvedVersions = append(unresolvedVersions, template.Version)
+				}
+			}
+
+			if len(unresolvedVersions) != 0 {
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved, "PathNotFound", "paths do not exist in the resource schema for versions: %s", strings.Join(unresolvedVersions, ", "))
+			} else {
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved, "PathsResolved", "")
+			}
+
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}}, handler.EnqueueRequestsFromMapFunc(
+				func(o client.Object) []reconcile.Request {
+					return []reconcile.Request{
+						{NamespacedName: types.NamespacedName{Name: o.GetName()}},
+					}
+				},
+			))
+			return nil
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings,verbs=get;list;watch
+
+func CountMappingServiceBindings() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "CountMappingServiceBindings",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterWorkloadResourceMapping) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+			if err := c.List(ctx, serviceBindings); err != nil {
+				return err
+			}
+
+			gr := schema.ParseGroupResource(resource.Name)
+			count := int32(0)
+			for i := range serviceBindings.Items {
+				if workloadGroupResource(c.RESTMapper(), serviceBindings.Items[i].Spec.Workload) == gr {
+					count++
+				}
+			}
+			resource.Status.ServiceBindings = count
+
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ServiceBinding{}}, handler.EnqueueRequestsFromMapFunc(
+				func(o client.Object) []reconcile.Request {
+					serviceBinding := o.(*servicebindingv1beta1.ServiceBinding)
+					gr := workloadGroupResource(mgr.GetRESTMapper(), serviceBinding.Spec.Workload)
+					if gr.Empty() {
+						return nil
+					}
+					return []reconcile.Request{
+						{NamespacedName: types.NamespacedName{Name: gr.String()}},
+					}
+				},
+			))
+			return nil
+		},
+	}
+}
+
+// CheckMappingPermissions reports the verbs the controller is missing for the mapped resource. Permissions are not
+// checked when the access checker is nil, or the resource is not resolved.
+func CheckMappingPermissions(accessChecker rbac.AccessChecker) reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "CheckMappingPermissions",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ClusterWorkloadResourceMapping) error {
+			resource.Status.MissingVerbs = nil
+			if accessChecker == nil {
+				return nil
+			}
+			if cond := resource.Status.GetCondition(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved); cond == nil || cond.Status != metav1.ConditionTrue {
+				return nil
+			}
+
+			gr := schema.ParseGroupResource(resource.Name)
+			if missing := rbac.MissingVerbs(ctx, accessChecker, gr, rbac.WorkloadVerbs); len(missing) != 0 {
+				resource.Status.MissingVerbs = missing
+			}
+
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			if accessChecker == nil {
+				return nil
+			}
+			// permissions are checked across all namespaces, only cluster scoped rbac resources affect the outcome
+			enqueue := handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+				accessChecker.Invalidate()
+				mappings := &servicebindingv1beta1.ClusterWorkloadResourceMappingList{}
+				if err := mgr.GetClient().List(ctx, mappings); err != nil {
+					return nil
+				}
+				requests := make([]reconcile.Request, len(mappings.Items))
+				for i := range mappings.Items {
+					requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: mappings.Items[i].Name}}
+				}
+				return requests
+			})
+			bldr.Watches(&source.Kind{Type: &rbacv1.ClusterRole{}}, enqueue)
+			bldr.Watches(&source.Kind{Type: &rbacv1.ClusterRoleBinding{}}, enqueue)
+			return nil
+		},
+	}
+}
+
+// workloadGroupResource resolves the resource of the workload reference, an empty value is returned if the kind is not
+// known to the mapper
+func workloadGroupResource(mapper meta.RESTMapper, workload servicebindingv1beta1.ServiceBindingWorkloadReference) schema.GroupResource {
+	return groupResource(mapper, workload.APIVersion, workload.Kind)
+}
+
+// serviceGroupResource resolves the resource of the service reference, an empty value is returned if the kind is not
+// known to the mapper
+func serviceGroupResource(mapper meta.RESTMapper, service servicebindingv1beta1.ServiceBindingServiceReference) schema.GroupResource {
+	return groupResource(mapper, service.APIVersion, service.Kind)
+}
+
+func groupResource(mapper meta.RESTMapper, apiVersion, kind string) schema.GroupResource {
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	rm, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupResource{}
+	}
+	return rm.Resource.GroupResource()
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/controllers"
+	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+	"github.com/scothis/servicebinding-runtime/rbac"
+)
+
+func TestClusterWorkloadResourceMappingReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	mapping := func(name, version string) *dieservicebindingv1beta1.ClusterWorkloadResourceMappingDie {
+		return dieservicebindingv1beta1.ClusterWorkloadResourceMappingBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+			}).
+			SpecDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingSpecDie) {
+				d.VersionsDie(version, func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingTemplateDie) {})
+			}).
+			DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMapping) {
+				r.Default()
+			})
+	}
+	deploymentMapping := mapping("deployments.apps", "*")
+	workloadMapping := mapping("workloads.example.com", "*")
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("my-namespace")
+			d.Name("my-binding")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name("my-workload")
+			})
+		})
+
+	container := apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"name":         {Type: "string"},
+			"env":          {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"}}},
+			"envFrom":      {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"}}},
+			"volumeMounts": {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"}}},
+		},
+	}
+	stringMap := apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+			Allows: true,
+			Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+		},
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "workloads.example.com",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural: "workloads",
+				Kind:   "Workload",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:   "v1",
+					Served: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"template": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"metadata": {
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"annotations": stringMap,
+														"labels":      stringMap,
+													},
+												},
+												"spec": {
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"containers": {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &container}},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rts := rtesting.ReconcilerTests{
+		"resource resolved": {
+			Request: reconcile.Request{NamespacedName: types.NamespacedName{Name: "deployments.apps"}},
+			GivenObjects: []client.Object{
+				deploymentMapping,
+				serviceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(deploymentMapping, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectStatusUpdates: []client.Object{
+				deploymentMapping.
+					StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved.True().Reason("SchemaUnavailable").
+								Message("the resource is not defined by a CustomResourceDefinition, paths were not checked"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionReady.True().Reason("Resolved"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.True().Reason("ResourceResolved"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed.True().Reason("VersionsServed"),
+						)
+						d.VersionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatusBlank.
+								Version("*").
+								Served(true),
+						)
+						d.ServiceBindings(1)
+					}),
+			},
+		},
+		"resource not found": {
+			Request: reconcile.Request{NamespacedName: types.NamespacedName{Name: "widgets.example.com"}},
+			GivenObjects: []client.Object{
+				mapping("widgets.example.com", "*"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(mapping("widgets.example.com", "*"), scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectStatusUpdates: []client.Object{
+				mapping("widgets.example.com", "*").
+					StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved.Reason("ResourceNotFound"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionReady.False().Reason("ResourceNotFound").
+								Message("the resource \"widgets.example.com\" is not known to the cluster"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.False().Reason("ResourceNotFound").
+								Message("the resource \"widgets.example.com\" is not known to the cluster"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed.Reason("ResourceNotFound"),
+						)
+						d.VersionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatusBlank.
+								Version("*").
+								Served(false),
+						)
+					}),
+			},
+		},
+		"version not served": {
+			Request: reconcile.Request{NamespacedName: types.NamespacedName{Name: "deployments.apps"}},
+			GivenObjects: []client.Object{
+				mapping("deployments.apps", "v1beta2"),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(deploymentMapping, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectStatusUpdates: []client.Object{
+				mapping("deployments.apps", "v1beta2").
+					StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved.True().Reason("SchemaUnavailable").
+								Message("the resource is not defined by a CustomResourceDefinition, paths were not checked"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionReady.False().Reason("VersionNotServed").
+								Message("versions are not served for the resource: v1beta2"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.True().Reason("ResourceResolved"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed.False().Reason("VersionNotServed").
+								Message("versions are not served for the resource: v1beta2"),
+						)
+						d.VersionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatusBlank.
+								Version("v1beta2").
+								Served(false),
+						)
+					}),
+			},
+		},
+		"unresolved paths": {
+			Request: reconcile.Request{NamespacedName: types.NamespacedName{Name: "workloads.example.com"}},
+			GivenObjects: []client.Object{
+				workloadMapping,
+				crd,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workloadMapping, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectStatusUpdates: []client.Object{
+				workloadMapping.
+					StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved.False().Reason("PathNotFound").
+								Message("paths do not exist in the resource schema for versions: *"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionReady.False().Reason("PathNotFound").
+								Message("paths do not exist in the resource schema for versions: *"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.True().Reason("ResourceResolved"),
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed.True().Reason("VersionsServed"),
+						)
+						d.VersionsDie(
+							dieservicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatusBlank.
+								Version("*").
+								Served(true).
+								PathsChecked(true).
+								UnresolvedPaths(
+									".spec.template.spec.imagePullSecrets",
+									".spec.template.spec.initContainers[*]",
+									".spec.template.spec.volumes",
+								),
+						)
+					}),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		restMapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Workload"}, meta.RESTScopeNamespace)
+		return controllers.ClusterWorkloadResourceMappingReconciler(c, nil)
+	})
+}
+
+func TestCheckMappingPermissions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	mapping := dieservicebindingv1beta1.ClusterWorkloadResourceMappingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("deployments.apps")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingSpecDie) {
+			d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingTemplateDie) {})
+		}).
+		StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+			d.ConditionsDie(
+				dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.True().Reason("ResourceResolved"),
+			)
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"permissions are not checked": {
+			Metadata: map[string]interface{}{
+				"DisableAccessChecker": true,
+			},
+			Resource: mapping.
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+					d.MissingVerbs("update")
+				}),
+			ExpectResource: mapping,
+		},
+		"all verbs permitted": {
+			Resource: mapping,
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "list"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "watch"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "update"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "patch"),
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewFor("apps", "deployments", "list"),
+				selfSubjectAccessReviewFor("apps", "deployments", "watch"),
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+				selfSubjectAccessReviewFor("apps", "deployments", "patch"),
+			},
+		},
+		"missing verbs": {
+			Resource: mapping,
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "list"),
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "watch"),
+			},
+			ExpectResource: mapping.
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+					d.MissingVerbs("update", "patch")
+				}),
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewFor("apps", "deployments", "list"),
+				selfSubjectAccessReviewFor("apps", "deployments", "watch"),
+				selfSubjectAccessReviewFor("apps", "deployments", "update"),
+				selfSubjectAccessReviewFor("apps", "deployments", "patch"),
+			},
+		},
+		"resource not resolved": {
+			Resource: mapping.
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.False().Reason("ResourceNotFound"),
+					)
+					d.MissingVerbs("update")
+				}),
+			ExpectResource: mapping.
+				StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved.False().Reason("ResourceNotFound"),
+					)
+				}),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		if disabled, _ := rtc.Metadata["DisableAccessChecker"].(bool); disabled {
+			return controllers.CheckMappingPermissions(nil)
+		}
+		return controllers.CheckMappingPermissions(rbac.NewAccessChecker(c, 0))
+	})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/discovery"
+)
+
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// MappingDiscoveryReconciler creates a ClusterWorkloadResourceMapping for each CustomResourceDefinition that opts in
+// with the discovery label
+func MappingDiscoveryReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler {
+	return &reconcilers.ResourceReconciler{
+		Name:       "MappingDiscovery",
+		Type:       &apiextensionsv1.CustomResourceDefinition{},
+		Reconciler: DiscoveredMapping(),
+
+		Config: c,
+	}
+}
+
+func DiscoveredMapping() reconcilers.SubReconciler {
+	return &reconcilers.ChildReconciler{
+		Name:          "DiscoveredMapping",
+		ChildType:     &servicebindingv1beta1.ClusterWorkloadResourceMapping{},
+		ChildListType: &servicebindingv1beta1.ClusterWorkloadResourceMappingList{},
+
+		DesiredChild: func(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) (*servicebindingv1beta1.ClusterWorkloadResourceMapping, error) {
+			if crd.Labels[discovery.DiscoverLabel] != "true" {
+				return nil, nil
+			}
+
+			log := logr.FromContextOrDiscard(ctx)
+			candidate, err := discovery.MappingForCRD(crd)
+			for _, note := range candidate.Notes {
+				log.Info("mapping discovery", "note", note)
+			}
+			if err != nil {
+				if errors.Is(err, discovery.ErrNoPodSpec) {
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			// never take over a mapping that was created by someone else
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			existing := &servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+			if err := c.Get(ctx, types.NamespacedName{Name: candidate.Mapping.Name}, existing); err != nil {
+				if !apierrs.IsNotFound(err) {
+					return nil, err
+				}
+			} else if !metav1.IsControlledBy(existing, crd) {
+				log.Info("mapping already exists, skipping discovery", "name", existing.Name)
+				return nil, nil
+			}
+
+			mapping := candidate.Mapping
+			mapping.Labels = map[string]string{
+				discovery.DiscoverLabel: "true",
+			}
+			return mapping, nil
+		},
+		ReflectChildStatusOnParent: func(crd *apiextensionsv1.CustomResourceDefinition, child *servicebindingv1beta1.ClusterWorkloadResourceMapping, err error) {
+			// the CustomResourceDefinition status is not ours to manage
+		},
+		MergeBeforeUpdate: func(current, desired *servicebindingv1beta1.ClusterWorkloadResourceMapping) {
+			current.Labels = desired.Labels
+			current.Spec = desired.Spec
+		},
+		SemanticEquals: func(a1, a2 *servicebindingv1beta1.ClusterWorkloadResourceMapping) bool {
+			return equality.Semantic.DeepEqual(a1.Spec, a2.Spec) &&
+				equality.Semantic.DeepEqual(a1.Labels, a2.Labels)
+		},
+		Sanitize: func(child *servicebindingv1beta1.ClusterWorkloadResourceMapping) servicebindingv1beta1.ClusterWorkloadResourceMappingSpec {
+			return child.Spec
+		},
+	}
+}
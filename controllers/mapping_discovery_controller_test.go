@@ -0,0 +1,188 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/controllers"
+	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+	"github.com/scothis/servicebinding-runtime/discovery"
+)
+
+func TestDiscoveredMapping(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	container := apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"name":  {Type: "string"},
+			"image": {Type: "string"},
+			"env":   {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"}}},
+		},
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apiextensions.k8s.io/v1",
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "workloads.example.com",
+			UID:  "6f9c8a5e-5b8e-4a3c-9f0e-3c1f3c6a6a0b",
+			Labels: map[string]string{
+				discovery.DiscoverLabel: "true",
+			},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural: "workloads",
+				Kind:   "Workload",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:   "v1",
+					Served: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"template": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"metadata": {Type: "object"},
+												"spec": {
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"containers": {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &container}},
+														"volumes":    {Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"}}},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	unlabeledCRD := crd.DeepCopy()
+	unlabeledCRD.Labels = nil
+
+	mapping := dieservicebindingv1beta1.ClusterWorkloadResourceMappingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("workloads.example.com")
+			d.AddLabel(discovery.DiscoverLabel, "true")
+			d.ControlledBy(crd, scheme)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingSpecDie) {
+			d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingTemplateDie) {
+				d.Annotations(".spec.template.metadata.annotations")
+				d.Labels(".spec.template.metadata.labels")
+				d.ContainersDie(
+					dieservicebindingv1beta1.ClusterWorkloadResourceMappingContainerBlank.
+						Path(".spec.template.spec.containers[*]").
+						Name(".name"),
+				)
+				d.Volumes(".spec.template.spec.volumes")
+			})
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"create mapping": {
+			Resource: crd,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(crd, scheme, corev1.EventTypeNormal, "Created", "Created ClusterWorkloadResourceMapping %q", "workloads.example.com"),
+			},
+			ExpectCreates: []client.Object{
+				mapping,
+			},
+		},
+		"in sync": {
+			Resource: crd,
+			GivenObjects: []client.Object{
+				mapping,
+			},
+		},
+		"update mapping": {
+			Resource: crd,
+			GivenObjects: []client.Object{
+				mapping.
+					SpecDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingSpecDie) {
+						d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingTemplateDie) {
+							d.Volumes(".spec.template.spec.other")
+						})
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(crd, scheme, corev1.EventTypeNormal, "Updated", "Updated ClusterWorkloadResourceMapping %q", "workloads.example.com"),
+			},
+			ExpectUpdates: []client.Object{
+				mapping,
+			},
+		},
+		"delete mapping when label removed": {
+			Resource: unlabeledCRD,
+			GivenObjects: []client.Object{
+				mapping,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(unlabeledCRD, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ClusterWorkloadResourceMapping %q", "workloads.example.com"),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				{Group: "servicebinding.io", Kind: "ClusterWorkloadResourceMapping", Name: "workloads.example.com"},
+			},
+		},
+		"ignore unlabeled crd": {
+			Resource: unlabeledCRD,
+		},
+		"ignore existing mapping not controlled by the crd": {
+			Resource: crd,
+			GivenObjects: []client.Object{
+				dieservicebindingv1beta1.ClusterWorkloadResourceMappingBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("workloads.example.com")
+					}),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.DiscoveredMapping()
+	})
+}
@@ -18,15 +18,29 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"strings"
 
+	"github.com/go-logr/logr"
 	"github.com/vmware-labs/reconciler-runtime/apis"
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	"github.com/vmware-labs/reconciler-runtime/tracker"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctlr "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +50,7 @@ import (
 
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 	"github.com/scothis/servicebinding-runtime/projector"
+	"github.com/scothis/servicebinding-runtime/rbac"
 	"github.com/scothis/servicebinding-runtime/resolver"
 )
 
@@ -44,16 +59,26 @@ import (
 //+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 
-// ServiceBindingReconciler reconciles a ServiceBinding object
-func ServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler {
+// ServiceBindingReconciler reconciles a ServiceBinding object. When an author access checker is provided, the binding
+// is only projected if the author of the ServiceBinding has access to the services and workloads. The type projections
+// declare the optional projections applied to bindings by their type.
+func ServiceBindingReconciler(c reconcilers.Config, authorAccessChecker rbac.SubjectAccessChecker, typeProjections map[string]projector.TypeProjection) *reconcilers.ResourceReconciler {
 	return &reconcilers.ResourceReconciler{
 		Type: &servicebindingv1beta1.ServiceBinding{},
 		Reconciler: &reconcilers.WithFinalizer{
 			Finalizer: servicebindingv1beta1.GroupVersion.Group + "/finalizer",
 			Reconciler: reconcilers.Sequence{
+				EnforceServiceBindingPolicies(),
+				AuthorizeServiceBindingAuthor(authorAccessChecker),
 				ResolveBindingSecret(),
+				CopyBindingSecret(),
+				SynthesizeBindingSecret(),
+				TransformBindingSecret(),
+				ResolveBindingConfigMap(),
+				ValidateBinding(),
+				ResolveAdditionalServices(),
 				ResolveWorkloads(),
-				ProjectBinding(),
+				ProjectBinding(typeProjections),
 				PatchWorkloads(),
 			},
 		},
@@ -62,44 +87,512 @@ func ServiceBindingReconciler(c reconcilers.Config) *reconcilers.ResourceReconci
 	}
 }
 
-func ResolveBindingSecret() reconcilers.SubReconciler {
+//+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindingpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// EnforceServiceBindingPolicies re-checks the ServiceBinding against the ServiceBindingPolicies selecting its
+// namespace, as policies may be created or changed after the ServiceBinding was admitted. The services of a
+// ServiceBinding that violates a policy are not resolved and the binding is unprojected from the workload.
+func EnforceServiceBindingPolicies() reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
-		Name: "ResolveBindingSecret",
+		Name: "EnforceServiceBindingPolicies",
 		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 
-			ref := corev1.ObjectReference{
-				APIVersion: resource.Spec.Service.APIVersion,
-				Kind:       resource.Spec.Service.Kind,
-				Namespace:  resource.Namespace,
-				Name:       resource.Spec.Service.Name,
-			}
-			secretName, err := resolver.New(c).LookupBindingSecret(ctx, ref)
+			violations, err := servicebindingv1beta1.AdmitServiceBinding(ctx, c, resource)
 			if err != nil {
-				if apierrs.IsNotFound(err) {
-					// leave Unknown, the provisioned service may be created shortly
-					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceNotFound", "the service was not found")
-					return nil
-				}
-				if apierrs.IsForbidden(err) {
-					// set False, the operator needs to give access to the resource
-					// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac
-					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceForbidden", "the controller does not have permission to get the service")
+				return err
+			}
+			if len(violations) != 0 {
+				// set False, the ServiceBinding or the policy need to change
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionPolicyCompliant, "PolicyViolation", "%s", violations.ToAggregate().Error())
+				StashPolicyViolations(ctx, violations)
+				return nil
+			}
+
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionPolicyCompliant, "AdmittedByPolicies", "")
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ServiceBindingPolicy{}}, enqueueServiceBindingsInNamespace(ctx, mgr, func(o client.Object) string {
+				// policies are cluster scoped and may select any namespace
+				return ""
+			}))
+			bldr.Watches(&source.Kind{Type: &corev1.Namespace{}}, enqueueServiceBindingsInNamespace(ctx, mgr, func(o client.Object) string {
+				// the labels of the namespace may be selected by a policy
+				return o.GetName()
+			}))
+			return nil
+		},
+	}
+}
+
+// enqueueServiceBindingsInNamespace enqueues each ServiceBinding in the namespace of the object, an empty namespace
+// enqueues every ServiceBinding
+func enqueueServiceBindingsInNamespace(ctx context.Context, mgr ctlr.Manager, namespaceOf func(client.Object) string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+		if err := mgr.GetClient().List(ctx, serviceBindings, client.InNamespace(namespaceOf(o))); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "unable to list ServiceBindings", "object", client.ObjectKeyFromObject(o))
+			return nil
+		}
+
+		requests := make([]reconcile.Request, len(serviceBindings.Items))
+		for i := range serviceBindings.Items {
+			requests[i] = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&serviceBindings.Items[i])}
+		}
+		return requests
+	})
+}
+
+//+kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// AuthorizeServiceBindingAuthor checks that the author of the ServiceBinding, as recorded by the ServiceBinding
+// webhook, is permitted to get the services and their binding secrets, and to update the workloads. A ServiceBinding
+// is not able to expose more than its author is able to access. The author is checked before the binding secret is
+// copied, synthesized or transformed, the later steps are skipped and the binding is unprojected from the workloads
+// when the author lacks access. The author is not checked when the access checker is nil.
+//
+// The ServiceBindings generated for a BindingPolicy are authored by the controller, the author of the BindingPolicy
+// is checked in addition. The author of the bindings annotation of a workload is not recorded, so the ServiceBindings
+// generated for the annotation are treated as having an unknown author.
+func AuthorizeServiceBindingAuthor(accessChecker rbac.SubjectAccessChecker) reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "AuthorizeServiceBindingAuthor",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			if accessChecker == nil || len(RetrievePolicyViolations(ctx)) != 0 {
+				// nothing is projected while the ServiceBinding violates a policy
+				return nil
+			}
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			r := resolver.New(c)
+
+			author, err := resource.GetAuthor()
+			if err != nil || author == nil {
+				// set False, the author is recorded when the spec of the ServiceBinding is changed
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "AuthorUnknown", "the author of the ServiceBinding is not recorded")
+				StashAuthorDenied(ctx, true)
+				return nil
+			}
+			authors := []*authenticationv1.UserInfo{author}
+			// the ServiceBindings generated for a BindingPolicy, or for the bindings annotation of a workload, are
+			// authored by the controller. The author of the policy must also have access, the author of the
+			// annotation is not known.
+			if owner := metav1.GetControllerOf(resource); owner != nil {
+				switch {
+				case owner.APIVersion == servicebindingv1beta1.GroupVersion.String() && owner.Kind == "BindingPolicy":
+					policy := &servicebindingv1beta1.BindingPolicy{}
+					if err := c.TrackAndGet(ctx, types.NamespacedName{Namespace: resource.Namespace, Name: owner.Name}, policy); err != nil && !apierrs.IsNotFound(err) {
+						return err
+					}
+					var policyAuthor *authenticationv1.UserInfo
+					if policy.UID == owner.UID {
+						// a malformed author is not recorded
+						policyAuthor, _ = policy.GetAuthor()
+					}
+					if policyAuthor == nil {
+						// set False, the author is recorded when the spec of the BindingPolicy is changed
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "AuthorUnknown", "the author of the BindingPolicy %q is not recorded", owner.Name)
+						StashAuthorDenied(ctx, true)
+						return nil
+					}
+					authors = append(authors, policyAuthor)
+				case owner.APIVersion == resource.Spec.Workload.APIVersion && owner.Kind == resource.Spec.Workload.Kind && owner.Name == resource.Spec.Workload.Name:
+					// set False, the binding needs to be declared by a ServiceBinding or BindingPolicy instead
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "AuthorUnknown", "the author of the bindings annotation of the workload %q is not recorded", owner.Name)
+					StashAuthorDenied(ctx, true)
 					return nil
 				}
-				// TODO handle other err cases
+			}
+			can := func(author authenticationv1.UserInfo, verb string, gr schema.GroupResource, namespace, name string) (bool, error) {
+				return accessChecker.Can(ctx, author, authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      verb,
+					Group:     gr.Group,
+					Resource:  gr.Resource,
+					Name:      name,
+				})
+			}
+
+			// resolve the services and binding secrets as ResolveBindingSecret and ResolveAdditionalServices will,
+			// without acting on them
+			services := []corev1.ObjectReference{}
+			secrets := []types.NamespacedName{}
+			candidates := append([]servicebindingv1beta1.ServiceBindingServiceReference{resource.Spec.Service}, resource.Spec.FallbackServices...)
+			resolved, err := resolveServiceCandidates(ctx, r, resource.Namespace, candidates)
+			if err != nil {
 				return err
 			}
+			if resolved.service != nil {
+				services = append(services, *resolved.service)
+				if resolved.secretName != "" {
+					secrets = append(secrets, types.NamespacedName{Namespace: resolved.service.Namespace, Name: resolved.secretName})
+				}
+			}
+			for _, additional := range resource.Spec.AdditionalServices {
+				resolved, err := resolveServiceCandidates(ctx, r, resource.Namespace, []servicebindingv1beta1.ServiceBindingServiceReference{additional.ServiceBindingServiceReference})
+				if err != nil {
+					return err
+				}
+				if resolved.service != nil {
+					services = append(services, *resolved.service)
+					if resolved.secretName != "" {
+						secrets = append(secrets, types.NamespacedName{Namespace: resource.Namespace, Name: resolved.secretName})
+					}
+				}
+			}
+			workloads, err := r.LookupWorkloads(ctx, corev1.ObjectReference{
+				APIVersion: resource.Spec.Workload.APIVersion,
+				Kind:       resource.Spec.Workload.Kind,
+				Namespace:  resource.Namespace,
+				Name:       resource.Spec.Workload.Name,
+			}, resource.Spec.Workload.Selector)
+			if err != nil {
+				if !apierrs.IsNotFound(err) && !apierrs.IsForbidden(err) && !meta.IsNoMatchError(err) {
+					return err
+				}
+				// reflected on the status by ResolveWorkloads
+				workloads = nil
+			}
 
-			if secretName != "" {
+			for _, author := range authors {
+				for _, service := range services {
+					allowed, err := can(*author, "get", groupResource(c.RESTMapper(), service.APIVersion, service.Kind), service.Namespace, service.Name)
+					if err != nil {
+						return err
+					}
+					if !allowed {
+						// set False, the author needs to be given access to the service
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AuthorForbidden", "the author %q does not have permission to get the service %q", author.Username, service.Name)
+						StashAuthorDenied(ctx, true)
+						return nil
+					}
+				}
+				for _, secret := range secrets {
+					allowed, err := can(*author, "get", schema.GroupResource{Resource: "secrets"}, secret.Namespace, secret.Name)
+					if err != nil {
+						return err
+					}
+					if !allowed {
+						// set False, the author needs to be given access to the binding secret
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AuthorForbidden", "the author %q does not have permission to get the binding secret %q", author.Username, secret.Name)
+						StashAuthorDenied(ctx, true)
+						return nil
+					}
+				}
+				for _, workload := range workloads {
+					workload := workload.(client.Object)
+					gvk := workload.GetObjectKind().GroupVersionKind()
+					allowed, err := can(*author, "update", groupResource(c.RESTMapper(), gvk.GroupVersion().String(), gvk.Kind), workload.GetNamespace(), workload.GetName())
+					if err != nil {
+						return err
+					}
+					if !allowed {
+						// set False, the author needs to be given access to the workload
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "AuthorForbidden", "the author %q does not have permission to update the workload %q", author.Username, workload.GetName())
+						StashAuthorDenied(ctx, true)
+						return nil
+					}
+				}
+			}
+
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.BindingPolicy{}}, reconcilers.EnqueueTracked(ctx, &servicebindingv1beta1.BindingPolicy{}))
+			return nil
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=referencegrants,verbs=get;list;watch
+
+func ResolveBindingSecret() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolveBindingSecret",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			r := resolver.New(c)
+
+			if len(RetrievePolicyViolations(ctx)) != 0 {
+				// leave Unknown, reflected on the status by EnforceServiceBindingPolicies
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "PolicyViolation", "the service is not resolved while the ServiceBinding violates a policy")
+				resource.Status.Binding = nil
+				resource.Status.Service = nil
+				resource.Status.ReferenceGrant = nil
+				return nil
+			}
+			if RetrieveAuthorDenied(ctx) {
+				if cond := resource.Status.GetCondition(servicebindingv1beta1.ServiceBindingConditionServiceAvailable); !apis.ConditionIsFalse(cond) {
+					// leave Unknown, reflected on the status by AuthorizeServiceBindingAuthor
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AuthorForbidden", "the service is not resolved while the author of the ServiceBinding lacks access")
+				}
+				resource.Status.Binding = nil
+				resource.Status.Service = nil
+				resource.Status.ReferenceGrant = nil
+				return nil
+			}
+
+			candidates := append([]servicebindingv1beta1.ServiceBindingServiceReference{resource.Spec.Service}, resource.Spec.FallbackServices...)
+			resolved, err := resolveServiceCandidates(ctx, r, resource.Namespace, candidates)
+			if err != nil {
+				return err
+			}
+			if resolved.secretName != "" {
 				// success
 				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ResolvedBindingSecret", "")
-				resource.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: secretName}
-			} else {
+				resource.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: resolved.secretName}
+				resource.Status.Service = activeService(resource.Namespace, *resolved.service)
+				resource.Status.ReferenceGrant = resolved.referenceGrantStatus()
+				return nil
+			}
+
+			if resolved.service != nil {
 				// leave Unknown, not success but also not an error
 				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceMissingBinding", "the service was found, but did not contain a binding secret")
 				// TODO should we clear the existing binding?
 				resource.Status.Binding = nil
+				resource.Status.Service = activeService(resource.Namespace, *resolved.service)
+				resource.Status.ReferenceGrant = resolved.referenceGrantStatus()
+				return nil
+			}
+			if resolved.ungranted != nil {
+				// set False, the namespace of the service needs to grant the reference. The binding is cleared so that
+				// revoking a grant unprojects the binding secret copied from the namespace of the service.
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceReferenceNotGranted", "the namespace %q does not grant a reference to the service from namespace %q", resolved.ungranted.Namespace, resource.Namespace)
+				resource.Status.Binding = nil
+				resource.Status.Service = nil
+				resource.Status.ReferenceGrant = nil
+				return nil
+			}
+			if apierrs.IsForbidden(resolved.lookupErr) {
+				// set False, the operator needs to give access to the resource
+				// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceForbidden", "the controller does not have permission to get the service")
+				return nil
+			}
+			var noMatch *meta.NoKindMatchError
+			if errors.As(resolved.lookupErr, &noMatch) {
+				// leave Unknown, the kind may be installed shortly
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceKindUnknown", "the service kind %s is not known to the cluster", noMatch.GroupKind)
+				return nil
+			}
+			// leave Unknown, the provisioned service may be created shortly
+			resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ServiceNotFound", "the service was not found")
+			return nil
+		},
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ReferenceGrant{}}, enqueueServiceBindingsForReferenceGrant(ctx, mgr))
+			return nil
+		},
+	}
+}
+
+// enqueueServiceBindingsForReferenceGrant enqueues the ServiceBindings, in any namespace, that reference a service in
+// the namespace of the grant
+func enqueueServiceBindingsForReferenceGrant(ctx context.Context, mgr ctlr.Manager) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+		if err := mgr.GetClient().List(ctx, serviceBindings); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "unable to list ServiceBindings for reference grant", "referenceGrant", client.ObjectKeyFromObject(o))
+			return nil
+		}
+
+		requests := []reconcile.Request{}
+		for i := range serviceBindings.Items {
+			serviceBinding := &serviceBindings.Items[i]
+			candidates := append([]servicebindingv1beta1.ServiceBindingServiceReference{serviceBinding.Spec.Service}, serviceBinding.Spec.FallbackServices...)
+			for _, candidate := range candidates {
+				if candidate.Namespace == o.GetNamespace() && serviceBinding.Namespace != o.GetNamespace() {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(serviceBinding)})
+					break
+				}
+			}
+		}
+		return requests
+	})
+}
+
+// resolvedService is the outcome of resolving an ordered collection of candidate services
+type resolvedService struct {
+	// service is the first service with a binding secret, falling back to the first service found
+	service *corev1.ObjectReference
+	// secretName is the binding secret of the service, empty when no candidate provides a binding secret
+	secretName string
+	// referenceGrant is the grant that permits the reference to the service in another namespace
+	referenceGrant string
+	// lookupErr is the first NotFound, Forbidden or NoMatch error encountered while resolving the candidates
+	lookupErr error
+	// ungranted is the first candidate in another namespace that is not granted to the namespace of the ServiceBinding
+	ungranted *corev1.ObjectReference
+}
+
+// referenceGrantStatus records the grant for a service in another namespace, nil is returned for a service in the
+// namespace of the ServiceBinding
+func (r resolvedService) referenceGrantStatus() *servicebindingv1beta1.ServiceBindingReferenceGrantStatus {
+	if r.referenceGrant == "" {
+		return nil
+	}
+	return &servicebindingv1beta1.ServiceBindingReferenceGrantStatus{
+		Namespace: r.service.Namespace,
+		Name:      r.referenceGrant,
+		Secret:    r.secretName,
+	}
+}
+
+// resolveServiceCandidates finds the first candidate service with a binding secret. NotFound, Forbidden and NoMatch
+// errors, and candidates in other namespaces without a ReferenceGrant, are collected on the result rather than returned.
+func resolveServiceCandidates(ctx context.Context, r resolver.Resolver, namespace string, candidates []servicebindingv1beta1.ServiceBindingServiceReference) (resolvedService, error) {
+	resolved := resolvedService{}
+	for _, candidate := range candidates {
+		ref := corev1.ObjectReference{
+			APIVersion: candidate.APIVersion,
+			Kind:       candidate.Kind,
+			Namespace:  namespace,
+			Name:       candidate.Name,
+		}
+		referenceGrant := ""
+		if candidate.Namespace != "" && candidate.Namespace != namespace {
+			ref.Namespace = candidate.Namespace
+			grant, err := lookupReferenceGrant(ctx, namespace, ref)
+			if err != nil {
+				return resolvedService{}, err
+			}
+			if grant == nil {
+				if resolved.ungranted == nil {
+					resolved.ungranted = &ref
+				}
+				continue
+			}
+			referenceGrant = grant.Name
+		}
+		refs, err := r.LookupServices(ctx, ref, candidate.Selector)
+		if err != nil {
+			if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) || meta.IsNoMatchError(err) {
+				if resolved.lookupErr == nil {
+					resolved.lookupErr = err
+				}
+				continue
+			}
+			return resolvedService{}, err
+		}
+		for i := range refs {
+			secretName, err := r.LookupBindingSecret(ctx, refs[i])
+			if err != nil {
+				if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) {
+					if resolved.lookupErr == nil {
+						resolved.lookupErr = err
+					}
+					continue
+				}
+				// TODO handle other err cases
+				return resolvedService{}, err
+			}
+			if secretName != "" {
+				resolved.service = &refs[i]
+				resolved.secretName = secretName
+				resolved.referenceGrant = referenceGrant
+				return resolved, nil
+			}
+			if resolved.service == nil {
+				resolved.service = &refs[i]
+				resolved.referenceGrant = referenceGrant
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// lookupReferenceGrant finds a ReferenceGrant in the namespace of the service that permits ServiceBindings in the
+// namespace to reference the service, nil is returned when the reference is not granted
+func lookupReferenceGrant(ctx context.Context, namespace string, ref corev1.ObjectReference) (*servicebindingv1beta1.ReferenceGrant, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	grants := &servicebindingv1beta1.ReferenceGrantList{}
+	if err := c.List(ctx, grants, client.InNamespace(ref.Namespace)); err != nil {
+		return nil, err
+	}
+	gk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind()
+	for i := range grants.Items {
+		if grants.Items[i].Permits(namespace, gk, ref.Name) {
+			return &grants.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// activeService reflects the resolved service on the status, the namespace is only set for a service in a namespace
+// other than the namespace of the ServiceBinding
+func activeService(namespace string, ref corev1.ObjectReference) *servicebindingv1beta1.ServiceBindingServiceReference {
+	service := &servicebindingv1beta1.ServiceBindingServiceReference{
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+	}
+	if ref.Namespace != namespace {
+		service.Namespace = ref.Namespace
+	}
+	return service
+}
+
+// activeServiceRef returns a reference to the service resolved by ResolveBindingSecret, false is returned when no
+// service was resolved
+func activeServiceRef(resource *servicebindingv1beta1.ServiceBinding) (corev1.ObjectReference, bool) {
+	service := resource.Status.Service
+	if service == nil {
+		return corev1.ObjectReference{}, false
+	}
+	namespace := service.Namespace
+	if namespace == "" {
+		namespace = resource.Namespace
+	}
+	return corev1.ObjectReference{
+		APIVersion: service.APIVersion,
+		Kind:       service.Kind,
+		Namespace:  namespace,
+		Name:       service.Name,
+	}, true
+}
+
+// ResolveBindingConfigMap resolves a config map with non-sensitive entries that is projected alongside the binding
+// secret. A direct binding to a ConfigMap has no binding secret.
+func ResolveBindingConfigMap() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolveBindingConfigMap",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			ref, ok := activeServiceRef(resource)
+			if !ok {
+				// reflected on the status by ResolveBindingSecret
+				resource.Status.ConfigMap = nil
+				return nil
+			}
+			if ref.Namespace != resource.Namespace {
+				// config maps are not copied from the namespace of the service
+				resource.Status.ConfigMap = nil
+				return nil
+			}
+			configMapName, err := resolver.New(c).LookupBindingConfigMap(ctx, ref)
+			if err != nil {
+				if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) {
+					// reflected on the status by ResolveBindingSecret
+					resource.Status.ConfigMap = nil
+					return nil
+				}
+				return err
+			}
+
+			if configMapName == "" {
+				resource.Status.ConfigMap = nil
+				return nil
+			}
+			resource.Status.ConfigMap = &servicebindingv1beta1.ServiceBindingConfigMapReference{Name: configMapName}
+			if resource.Status.Binding == nil {
+				// success, the binding only has non-sensitive entries
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "ResolvedBindingConfigMap", "")
 			}
 
 			return nil
@@ -107,6 +600,613 @@ func ResolveBindingSecret() reconcilers.SubReconciler {
 	}
 }
 
+//+kubebuilder:rbac:groups=servicebinding.io,resources=bindingtypeschemas,verbs=get;list;watch
+
+// ValidateBinding checks the entries of the resolved binding secret, or config map, for the `type` entry required by
+// the spec, the entries referenced by the env mappings, and the entries required by the BindingTypeSchema for the
+// type. The outcome is reflected by the BindingValid condition.
+//
+// The type and provider of the binding are reflected on the status, the values from the spec take precedence over the
+// entries of the binding. The BindingValid condition reports when the values differ. The `type` entry of the binding must be
+// permitted by the ServiceBindingPolicies, regardless of the type in the spec.
+func ValidateBinding() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ValidateBinding",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			resource.Status.Type = resource.Spec.Type
+			resource.Status.Provider = resource.Spec.Provider
+
+			if resource.Status.Binding == nil && resource.Status.ConfigMap == nil {
+				// leave Unknown, reflected on the status by ResolveBindingSecret
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionBindingValid, "BindingNotResolved", "the binding has not been resolved")
+				return nil
+			}
+
+			// entries available to env mappings, the binding secret when defined, otherwise the config map
+			var envEntries map[string]string
+			entries := sets.NewString()
+			var serviceType, serviceProvider string
+
+			if resource.Status.ConfigMap != nil {
+				configMap := &corev1.ConfigMap{}
+				if err := c.TrackAndGet(ctx, types.NamespacedName{Namespace: resource.Namespace, Name: resource.Status.ConfigMap.Name}, configMap); err != nil {
+					if apierrs.IsNotFound(err) {
+						// leave Unknown, the config map may be created shortly
+						resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionBindingValid, "BindingConfigMapNotFound", "the config map %q was not found", resource.Status.ConfigMap.Name)
+						return nil
+					}
+					return err
+				}
+				envEntries = configMap.Data
+				entries.Insert(sets.StringKeySet(configMap.Data).UnsortedList()...)
+				entries.Insert(sets.StringKeySet(configMap.BinaryData).UnsortedList()...)
+				serviceType = configMap.Data["type"]
+				serviceProvider = configMap.Data["provider"]
+			}
+			if resource.Status.Binding != nil {
+				secret := &corev1.Secret{}
+				if err := trackAndGetSecret(ctx, c, types.NamespacedName{Namespace: resource.Namespace, Name: resource.Status.Binding.Name}, secret); err != nil {
+					if apierrs.IsNotFound(err) {
+						// leave Unknown, the binding secret may be created shortly
+						resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionBindingValid, "BindingSecretNotFound", "the binding secret %q was not found", resource.Status.Binding.Name)
+						return nil
+					}
+					return err
+				}
+				envEntries = make(map[string]string, len(secret.Data))
+				for k, v := range secret.Data {
+					envEntries[k] = string(v)
+				}
+				entries.Insert(sets.StringKeySet(secret.Data).UnsortedList()...)
+				if v, ok := secret.Data["type"]; ok {
+					serviceType = string(v)
+				}
+				if v, ok := secret.Data["provider"]; ok {
+					serviceProvider = string(v)
+				}
+			}
+			if serviceType != "" {
+				// the type from the spec is admitted by EnforceServiceBindingPolicies, declaring a permitted type in the
+				// spec must not bind a service of another type
+				violations, err := servicebindingv1beta1.AdmitServiceBindingType(ctx, c, resource, serviceType)
+				if err != nil {
+					return err
+				}
+				if len(violations) != 0 {
+					// set False, the service or the policy need to change
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionPolicyCompliant, "PolicyViolation", "%s", violations.ToAggregate().Error())
+					StashPolicyViolations(ctx, violations)
+				}
+			}
+			overrides := []string{}
+			if resource.Spec.Type != "" {
+				entries.Insert("type")
+				if serviceType != "" && serviceType != resource.Spec.Type {
+					overrides = append(overrides, fmt.Sprintf("the type %q of the binding is overridden by %q from the spec", serviceType, resource.Spec.Type))
+				}
+			} else {
+				resource.Status.Type = serviceType
+			}
+			if resource.Spec.Provider != "" {
+				entries.Insert("provider")
+				if serviceProvider != "" && serviceProvider != resource.Spec.Provider {
+					overrides = append(overrides, fmt.Sprintf("the provider %q of the binding is overridden by %q from the spec", serviceProvider, resource.Spec.Provider))
+				}
+			} else {
+				resource.Status.Provider = serviceProvider
+			}
+			bindingType := resource.Status.Type
+
+			if bindingType == "" {
+				// set False, the service or the binding need to change
+				// see https://servicebinding.io/spec/core/1.0.0/#provisioned-service
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionBindingValid, "MissingType", "the binding must contain a %q entry", "type")
+				return nil
+			}
+
+			missingEnvKeys := sets.NewString()
+			for _, e := range resource.Spec.Env {
+				if e.Key == "type" && resource.Spec.Type != "" {
+					continue
+				}
+				if e.Key == "provider" && resource.Spec.Provider != "" {
+					continue
+				}
+				if _, ok := envEntries[e.Key]; !ok {
+					missingEnvKeys.Insert(e.Key)
+				}
+			}
+			if missingEnvKeys.Len() != 0 {
+				// set False, the service or the env mappings need to change
+				resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionBindingValid, "MissingEnvKeys", "the binding does not contain the entries %q referenced by spec.env", missingEnvKeys.List())
+				return nil
+			}
+
+			schema := &servicebindingv1beta1.BindingTypeSchema{}
+			if err := c.TrackAndGet(ctx, types.NamespacedName{Name: bindingType}, schema); err != nil {
+				if !apierrs.IsNotFound(err) {
+					return err
+				}
+				// types without a schema are not checked further
+				schema = nil
+			}
+			if schema != nil {
+				missingKeys := sets.NewString(schema.Spec.RequiredKeys...).Difference(entries)
+				if missingKeys.Len() != 0 {
+					// set False, the service needs to change
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionBindingValid, "MissingRequiredKeys", "the binding of type %q does not contain the required entries %q", bindingType, missingKeys.List())
+					return nil
+				}
+			}
+
+			if len(overrides) != 0 {
+				// the binding is valid, but may not be what the service intended
+				resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionBindingValid, "OverriddenBySpec", "%s", strings.Join(overrides, "; "))
+				return nil
+			}
+			resource.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionBindingValid, "ValidatedBinding", "")
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &corev1.Secret{}}, reconcilers.EnqueueTracked(ctx, &corev1.Secret{}))
+			bldr.Watches(&source.Kind{Type: &corev1.ConfigMap{}}, reconcilers.EnqueueTracked(ctx, &corev1.ConfigMap{}))
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.BindingTypeSchema{}}, reconcilers.EnqueueTracked(ctx, &servicebindingv1beta1.BindingTypeSchema{}))
+			return nil
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+
+// ResolveAdditionalServices resolves the binding secret of each additional service. Each secret is projected into
+// the same binding as the service, either merged into the root of the binding or into a subdirectory. A service whose
+// entries conflict with the entries of the service, or of a prior additional service, is not projected.
+func ResolveAdditionalServices() reconcilers.SubReconciler {
+	return &reconcilers.SyncReconciler{
+		Name: "ResolveAdditionalServices",
+		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			r := resolver.New(c)
+
+			if len(resource.Spec.AdditionalServices) == 0 || len(RetrievePolicyViolations(ctx)) != 0 || RetrieveAuthorDenied(ctx) {
+				resource.Status.AdditionalServices = nil
+				return nil
+			}
+
+			paths, err := primaryBindingPaths(ctx, c, resource)
+			if err != nil {
+				return err
+			}
+
+			statuses := make([]servicebindingv1beta1.ServiceBindingAdditionalServiceStatus, len(resource.Spec.AdditionalServices))
+			for i, additional := range resource.Spec.AdditionalServices {
+				status := servicebindingv1beta1.ServiceBindingAdditionalServiceStatus{
+					Path: additional.Path,
+				}
+				if i < len(resource.Status.AdditionalServices) {
+					status.Conditions = resource.Status.AdditionalServices[i].Conditions
+				}
+
+				resolved, err := resolveServiceCandidates(ctx, r, resource.Namespace, []servicebindingv1beta1.ServiceBindingServiceReference{additional.ServiceBindingServiceReference})
+				if err != nil {
+					return err
+				}
+				if resolved.service != nil {
+					status.Service = activeService(resource.Namespace, *resolved.service)
+				}
+
+				switch {
+				case resolved.secretName != "":
+					secret := &corev1.Secret{}
+					if err := trackAndGetSecret(ctx, c, types.NamespacedName{Namespace: resource.Namespace, Name: resolved.secretName}, secret); err != nil {
+						if !apierrs.IsNotFound(err) {
+							return err
+						}
+						// leave Unknown, the binding secret may be created shortly
+						setAdditionalServiceCondition(&status, metav1.ConditionUnknown, "BindingSecretNotFound", "the binding secret %q was not found", resolved.secretName)
+						break
+					}
+					keys := sets.StringKeySet(secret.Data).List()
+					if conflict, ok := paths.claim(additional.Path, keys); !ok {
+						// set False, the services or paths of the binding need to change
+						setAdditionalServiceCondition(&status, metav1.ConditionFalse, "BindingKeyConflict", "the entry %q conflicts with an entry of another service", conflict)
+						break
+					}
+					status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: resolved.secretName}
+					if additional.Path != "" {
+						status.Keys = keys
+					}
+					setAdditionalServiceCondition(&status, metav1.ConditionTrue, "ResolvedBindingSecret", "")
+				case resolved.service != nil:
+					// leave Unknown, not success but also not an error
+					setAdditionalServiceCondition(&status, metav1.ConditionUnknown, "ServiceMissingBinding", "the service was found, but did not contain a binding secret")
+				case apierrs.IsForbidden(resolved.lookupErr):
+					// set False, the operator needs to give access to the resource
+					setAdditionalServiceCondition(&status, metav1.ConditionFalse, "ServiceForbidden", "the controller does not have permission to get the service")
+				default:
+					// leave Unknown, the provisioned service may be created shortly
+					setAdditionalServiceCondition(&status, metav1.ConditionUnknown, "ServiceNotFound", "the service was not found")
+				}
+				statuses[i] = status
+			}
+			resource.Status.AdditionalServices = statuses
+
+			if cond := resource.GetConditionManager().GetCondition(servicebindingv1beta1.ServiceBindingConditionServiceAvailable); cond == nil || cond.Status != metav1.ConditionTrue {
+				// the service is not available, the additional services do not change the outcome
+				return nil
+			}
+			for i, status := range statuses {
+				cond := meta.FindStatusCondition(status.Conditions, servicebindingv1beta1.ServiceBindingConditionServiceAvailable)
+				switch cond.Status {
+				case metav1.ConditionTrue:
+					continue
+				case metav1.ConditionFalse:
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AdditionalServiceNotAvailable", "additional service %d: %s", i, cond.Message)
+				default:
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "AdditionalServiceNotAvailable", "additional service %d: %s", i, cond.Message)
+				}
+				return nil
+			}
+
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &corev1.Secret{}}, reconcilers.EnqueueTracked(ctx, &corev1.Secret{}))
+			return nil
+		},
+	}
+}
+
+func setAdditionalServiceCondition(status *servicebindingv1beta1.ServiceBindingAdditionalServiceStatus, conditionStatus metav1.ConditionStatus, reason, messageFormat string, messageA ...interface{}) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    servicebindingv1beta1.ServiceBindingConditionServiceAvailable,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: fmt.Sprintf(messageFormat, messageA...),
+	})
+}
+
+// primaryBindingPaths collects the paths projected into the root of the binding by the service
+func primaryBindingPaths(ctx context.Context, c reconcilers.Config, resource *servicebindingv1beta1.ServiceBinding) (*bindingPaths, error) {
+	paths := &bindingPaths{
+		files: sets.NewString(),
+		dirs:  sets.NewString(),
+	}
+	if resource.Spec.Type != "" {
+		paths.claim("", []string{"type"})
+	}
+	if resource.Spec.Provider != "" {
+		paths.claim("", []string{"provider"})
+	}
+	if resource.Status.Binding != nil {
+		secret := &corev1.Secret{}
+		if err := trackAndGetSecret(ctx, c, types.NamespacedName{Namespace: resource.Namespace, Name: resource.Status.Binding.Name}, secret); err != nil {
+			if !apierrs.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		paths.claim("", sets.StringKeySet(secret.Data).List())
+	}
+	if resource.Status.ConfigMap != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := c.TrackAndGet(ctx, types.NamespacedName{Namespace: resource.Namespace, Name: resource.Status.ConfigMap.Name}, configMap); err != nil {
+			if !apierrs.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		paths.claim("", sets.StringKeySet(configMap.Data).Union(sets.StringKeySet(configMap.BinaryData)).List())
+	}
+	return paths, nil
+}
+
+// bindingPaths tracks the files projected into a binding, and the directories that contain them
+type bindingPaths struct {
+	files sets.String
+	dirs  sets.String
+}
+
+// claim records the files for the keys within the directory. When any file conflicts with a previously claimed file
+// or directory, no files are recorded and the conflicting path is returned.
+func (p *bindingPaths) claim(dir string, keys []string) (string, bool) {
+	for _, key := range keys {
+		file := path.Join(dir, key)
+		if p.files.Has(file) || p.dirs.Has(file) {
+			return file, false
+		}
+		for parent := path.Dir(file); parent != "."; parent = path.Dir(parent) {
+			if p.files.Has(parent) {
+				return file, false
+			}
+		}
+	}
+	for _, key := range keys {
+		file := path.Join(dir, key)
+		p.files.Insert(file)
+		for parent := path.Dir(file); parent != "."; parent = path.Dir(parent) {
+			p.dirs.Insert(parent)
+		}
+	}
+	return "", true
+}
+
+// OwnedSecretLabel marks the secrets owned by a ServiceBinding. Only secrets with the label are cached by the manager,
+// see BindingSecretCacheSelector.
+var OwnedSecretLabel = servicebindingv1beta1.GroupVersion.Group + "/owned"
+
+// BindingSecretCacheSelector selects the secrets cached by the manager, the secrets owned by ServiceBindings. Binding
+// secrets authored by users are read by name, see trackAndGetSecret.
+func BindingSecretCacheSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{OwnedSecretLabel: "true"})
+}
+
+// trackAndGetSecret tracks the secret for changes and gets the secret directly from the API server, as the manager
+// only caches the secrets owned by ServiceBindings rather than every secret in the cluster. Changes to an owned secret
+// enqueue the tracking ServiceBindings, other secrets are observed when the ServiceBinding is next reconciled.
+func trackAndGetSecret(ctx context.Context, c reconcilers.Config, key types.NamespacedName, secret *corev1.Secret) error {
+	c.Tracker.Track(
+		ctx,
+		tracker.NewKey(corev1.SchemeGroupVersion.WithKind("Secret"), key),
+		reconcilers.RetrieveRequest(ctx).NamespacedName,
+	)
+	return c.APIReader.Get(ctx, key, secret)
+}
+
+// copiedBindingSecretLabel marks the binding secret copied by CopyBindingSecret, distinguishing it from the binding
+// secrets synthesized by SynthesizeBindingSecret and transformed by TransformBindingSecret
+var copiedBindingSecretLabel = servicebindingv1beta1.GroupVersion.Group + "/copied"
+
+// CopyBindingSecret copies the binding secret of a service in another namespace into a secret owned by the
+// ServiceBinding, as pods can only mount secrets from their own namespace. The copy is projected in place of the
+// binding secret and is removed when the ReferenceGrant for the service is revoked.
+func CopyBindingSecret() reconcilers.SubReconciler {
+	return &reconcilers.ChildReconciler{
+		Name:          "CopyBindingSecret",
+		ChildType:     &corev1.Secret{},
+		ChildListType: &corev1.SecretList{},
+		OurChild: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret) bool {
+			return child.Labels[copiedBindingSecretLabel] == "true"
+		},
+
+		DesiredChild: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) (*corev1.Secret, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if RetrieveAuthorDenied(ctx) {
+				// reflected on the status by AuthorizeServiceBindingAuthor
+				return nil, nil
+			}
+			grant := resource.Status.ReferenceGrant
+			if grant == nil || grant.Secret == "" || resource.Status.Binding == nil {
+				return nil, nil
+			}
+			secret := &corev1.Secret{}
+			if err := trackAndGetSecret(ctx, c, types.NamespacedName{Namespace: grant.Namespace, Name: grant.Secret}, secret); err != nil {
+				if apierrs.IsNotFound(err) {
+					// leave Unknown, the binding secret may be created shortly
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "BindingSecretNotFound", "the binding secret %q was not found in namespace %q", grant.Secret, grant.Namespace)
+					resource.Status.Binding = nil
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    resource.Namespace,
+					GenerateName: fmt.Sprintf("%s-", resource.Name),
+					Labels: map[string]string{
+						OwnedSecretLabel:         "true",
+						copiedBindingSecretLabel: "true",
+					},
+				},
+				Type: secret.Type,
+				Data: secret.Data,
+			}, nil
+		},
+		ReflectChildStatusOnParent: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret, err error) {
+			if err != nil || child == nil {
+				// the status is reflected by ResolveBindingSecret
+				return
+			}
+			parent.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "CopiedBindingSecret", "")
+			parent.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: child.Name}
+		},
+		MergeBeforeUpdate: func(current, desired *corev1.Secret) {
+			// the type of a secret is immutable
+			current.Labels = desired.Labels
+			current.Data = desired.Data
+		},
+		SemanticEquals: func(a1, a2 *corev1.Secret) bool {
+			return equality.Semantic.DeepEqual(a1.Labels, a2.Labels) &&
+				equality.Semantic.DeepEqual(a1.Data, a2.Data)
+		},
+		Sanitize: func(child *corev1.Secret) []string {
+			// never log the values of the secret
+			return sets.StringKeySet(child.Data).List()
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &corev1.Secret{}}, reconcilers.EnqueueTracked(ctx, &corev1.Secret{}))
+			return nil
+		},
+	}
+}
+
+// RBAC can't be scoped by label, list and watch are used by the manager's cache of the secrets labeled with
+// OwnedSecretLabel
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=servicebinding.io,resources=clusterserviceresourcemappings,verbs=get;list;watch
+
+// SynthesizeBindingSecret creates a binding secret from the fields of the service when a ClusterServiceResourceMapping
+// for the service defines the entries of the secret. The secret is owned by the ServiceBinding.
+func SynthesizeBindingSecret() reconcilers.SubReconciler {
+	return &reconcilers.ChildReconciler{
+		Name:          "SynthesizeBindingSecret",
+		ChildType:     &corev1.Secret{},
+		ChildListType: &corev1.SecretList{},
+		OurChild: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret) bool {
+			return child.Labels[transformedBindingSecretLabel] != "true" && child.Labels[copiedBindingSecretLabel] != "true"
+		},
+
+		DesiredChild: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) (*corev1.Secret, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if RetrieveAuthorDenied(ctx) {
+				// reflected on the status by AuthorizeServiceBindingAuthor
+				return nil, nil
+			}
+			ref, ok := activeServiceRef(resource)
+			if !ok {
+				// reflected on the status by ResolveBindingSecret
+				return nil, nil
+			}
+			data, err := resolver.New(c).LookupBindingSecretData(ctx, ref)
+			if err != nil {
+				if errors.Is(err, resolver.ErrBindingSecretTemplate) {
+					// set False, the mapping or the service need to change
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "BindingSecretTemplateFailed", "%s", err)
+					return nil, nil
+				}
+				if apierrs.IsNotFound(err) || apierrs.IsForbidden(err) {
+					// reflected on the status by ResolveBindingSecret
+					return nil, nil
+				}
+				return nil, err
+			}
+			if data == nil {
+				return nil, nil
+			}
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    resource.Namespace,
+					GenerateName: fmt.Sprintf("%s-", resource.Name),
+					Labels: map[string]string{
+						OwnedSecretLabel: "true",
+					},
+				},
+				Data: data,
+			}, nil
+		},
+		ReflectChildStatusOnParent: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret, err error) {
+			if err != nil || child == nil {
+				// the status is reflected by ResolveBindingSecret
+				return
+			}
+			parent.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "SynthesizedBindingSecret", "")
+			parent.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: child.Name}
+		},
+		MergeBeforeUpdate: func(current, desired *corev1.Secret) {
+			current.Labels = desired.Labels
+			current.Data = desired.Data
+		},
+		SemanticEquals: func(a1, a2 *corev1.Secret) bool {
+			return equality.Semantic.DeepEqual(a1.Labels, a2.Labels) &&
+				equality.Semantic.DeepEqual(a1.Data, a2.Data)
+		},
+		Sanitize: func(child *corev1.Secret) []string {
+			// never log the values of the secret
+			return sets.StringKeySet(child.Data).List()
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterServiceResourceMapping{}}, enqueueServiceBindingsForMapping(ctx, mgr, func(serviceBinding *servicebindingv1beta1.ServiceBinding) schema.GroupResource {
+				return serviceGroupResource(mgr.GetRESTMapper(), serviceBinding.Spec.Service)
+			}))
+			return nil
+		},
+	}
+}
+
+// transformedBindingSecretLabel marks the binding secret materialized by TransformBindingSecret, distinguishing it from
+// the binding secret synthesized by SynthesizeBindingSecret
+var transformedBindingSecretLabel = servicebindingv1beta1.GroupVersion.Group + "/transformed"
+
+// TransformBindingSecret materializes the entries of the binding secret, as transformed by the ServiceBinding, into a
+// secret owned by the ServiceBinding. The transformed secret is projected in place of the binding secret.
+func TransformBindingSecret() reconcilers.SubReconciler {
+	return &reconcilers.ChildReconciler{
+		Name:          "TransformBindingSecret",
+		ChildType:     &corev1.Secret{},
+		ChildListType: &corev1.SecretList{},
+		OurChild: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret) bool {
+			return child.Labels[transformedBindingSecretLabel] == "true"
+		},
+
+		DesiredChild: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) (*corev1.Secret, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if RetrieveAuthorDenied(ctx) {
+				// reflected on the status by AuthorizeServiceBindingAuthor
+				return nil, nil
+			}
+			if resource.Spec.Transform == nil || resource.Status.Binding == nil {
+				return nil, nil
+			}
+			secret := &corev1.Secret{}
+			if err := trackAndGetSecret(ctx, c, types.NamespacedName{Namespace: resource.Namespace, Name: resource.Status.Binding.Name}, secret); err != nil {
+				if apierrs.IsNotFound(err) {
+					// leave Unknown, the binding secret may be created shortly
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "BindingSecretNotFound", "the binding secret %q was not found", resource.Status.Binding.Name)
+					resource.Status.Binding = nil
+					return nil, nil
+				}
+				return nil, err
+			}
+			data, err := resource.Spec.Transform.Apply(secret.Data)
+			if err != nil {
+				if errors.Is(err, servicebindingv1beta1.ErrBindingTransform) {
+					// set False, the transform or the binding secret need to change
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "BindingTransformFailed", "%s", err)
+					resource.Status.Binding = nil
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    resource.Namespace,
+					GenerateName: fmt.Sprintf("%s-", resource.Name),
+					Labels: map[string]string{
+						OwnedSecretLabel:              "true",
+						transformedBindingSecretLabel: "true",
+					},
+				},
+				Data: data,
+			}, nil
+		},
+		ReflectChildStatusOnParent: func(parent *servicebindingv1beta1.ServiceBinding, child *corev1.Secret, err error) {
+			if err != nil || child == nil {
+				// the status is reflected by ResolveBindingSecret
+				return
+			}
+			parent.GetConditionManager().MarkTrue(servicebindingv1beta1.ServiceBindingConditionServiceAvailable, "TransformedBindingSecret", "")
+			parent.Status.Binding = &servicebindingv1beta1.ServiceBindingSecretReference{Name: child.Name}
+		},
+		MergeBeforeUpdate: func(current, desired *corev1.Secret) {
+			current.Labels = desired.Labels
+			current.Data = desired.Data
+		},
+		SemanticEquals: func(a1, a2 *corev1.Secret) bool {
+			return equality.Semantic.DeepEqual(a1.Labels, a2.Labels) &&
+				equality.Semantic.DeepEqual(a1.Data, a2.Data)
+		},
+		Sanitize: func(child *corev1.Secret) []string {
+			// never log the values of the secret
+			return sets.StringKeySet(child.Data).List()
+		},
+
+		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
+			bldr.Watches(&source.Kind{Type: &corev1.Secret{}}, reconcilers.EnqueueTracked(ctx, &corev1.Secret{}))
+			return nil
+		},
+	}
+}
+
 func ResolveWorkloads() reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name:                   "ResolveWorkloads",
@@ -122,6 +1222,12 @@ func ResolveWorkloads() reconcilers.SubReconciler {
 			}
 			workloads, err := resolver.New(c).LookupWorkloads(ctx, ref, resource.Spec.Workload.Selector)
 			if err != nil {
+				if meta.IsNoMatchError(err) {
+					// leave Unknown, the kind may be installed shortly
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadKindUnknown", "the workload kind %s is not known to the cluster", schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind())
+					// TODO use track rather than requeue
+					return reconcile.Result{Requeue: true}, nil
+				}
 				if apierrs.IsNotFound(err) {
 					// leave Unknown, the workload may be created shortly
 					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadNotFound", "the workload was not found")
@@ -131,11 +1237,11 @@ func ResolveWorkloads() reconcilers.SubReconciler {
 				if apierrs.IsForbidden(err) {
 					// set False, the operator needs to give access to the resource
 					// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac-1
+					message := "the controller does not have permission to get the workload"
 					if resource.Spec.Workload.Name == "" {
-						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to list the workloads")
-					} else {
-						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to get the workload")
+						message = "the controller does not have permission to list the workloads"
 					}
+					resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "%s", withMissingWorkloadVerbs(ctx, c, resource, message))
 					// TODO use track rather than requeue
 					return reconcile.Result{Requeue: true}, nil
 				}
@@ -151,44 +1257,85 @@ func ResolveWorkloads() reconcilers.SubReconciler {
 }
 
 //+kubebuilder:rbac:groups=servicebinding.io,resources=clusterworkloadresourcemappings,verbs=get;list;watch
+//+kubebuilder:rbac:groups=servicebinding.io,resources=workloadresourcemappings,verbs=get;list;watch
 
-func ProjectBinding() reconcilers.SubReconciler {
+func ProjectBinding(typeProjections map[string]projector.TypeProjection) reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name:                   "ProjectBinding",
 		SyncDuringFinalization: true,
 		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
 			c := reconcilers.RetrieveConfigOrDie(ctx)
-			projector := projector.New(resolver.New(c))
 
 			workloads := RetrieveWorkloads(ctx)
 			projectedWorkloads := make([]runtime.Object, len(workloads))
+			projectionPlans := make([]*projector.Plan, len(workloads))
+
+			projector := projector.NewWithTypeProjections(resolver.New(c), typeProjections)
+			planner := projector.PlanProject
+			if !resource.DeletionTimestamp.IsZero() {
+				planner = projector.PlanUnproject
+			}
+			if len(RetrievePolicyViolations(ctx)) != 0 {
+				// leave Unknown, reflected on the status by EnforceServiceBindingPolicies
+				resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "PolicyViolation", "the binding is not projected while the ServiceBinding violates a policy")
+				planner = projector.PlanUnproject
+			}
+			if RetrieveAuthorDenied(ctx) {
+				if cond := resource.Status.GetCondition(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected); !apis.ConditionIsFalse(cond) {
+					// leave Unknown, reflected on the status by AuthorizeServiceBindingAuthor
+					resource.GetConditionManager().MarkUnknown(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "AuthorForbidden", "the binding is not projected while the author of the ServiceBinding lacks access")
+				}
+				planner = projector.PlanUnproject
+			}
 
 			for i := range workloads {
-				workload := workloads[i].DeepCopyObject()
-				if !resource.DeletionTimestamp.IsZero() {
-					if err := projector.Unproject(ctx, resource, workload); err != nil {
-						return err
-					}
-				} else {
-					if err := projector.Project(ctx, resource, workload); err != nil {
-						return err
-					}
+				plan, err := planner(ctx, resource, workloads[i])
+				if err != nil {
+					return err
 				}
-				projectedWorkloads[i] = workload
+				projectedWorkloads[i] = plan.Workload
+				projectionPlans[i] = plan
 			}
 
 			StashProjectedWorkloads(ctx, projectedWorkloads)
+			StashProjectionPlans(ctx, projectionPlans)
 
 			return nil
 		},
 
 		Setup: func(ctx context.Context, mgr ctlr.Manager, bldr *builder.Builder) error {
-			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterWorkloadResourceMapping{}}, handler.Funcs{})
+			workloadResource := func(serviceBinding *servicebindingv1beta1.ServiceBinding) schema.GroupResource {
+				return workloadGroupResource(mgr.GetRESTMapper(), serviceBinding.Spec.Workload)
+			}
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.ClusterWorkloadResourceMapping{}}, enqueueServiceBindingsForMapping(ctx, mgr, workloadResource))
+			bldr.Watches(&source.Kind{Type: &servicebindingv1beta1.WorkloadResourceMapping{}}, enqueueServiceBindingsForMapping(ctx, mgr, workloadResource))
 			return nil
 		},
 	}
 }
 
+// enqueueServiceBindingsForMapping enqueues each ServiceBinding that references a resource named by the mapping. A
+// namespaced mapping only affects ServiceBindings in the same namespace.
+func enqueueServiceBindingsForMapping(ctx context.Context, mgr ctlr.Manager, resourceOf func(*servicebindingv1beta1.ServiceBinding) schema.GroupResource) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+		if err := mgr.GetClient().List(ctx, serviceBindings, client.InNamespace(o.GetNamespace())); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "unable to list ServiceBindings for mapping", "mapping", client.ObjectKeyFromObject(o))
+			return nil
+		}
+
+		gr := schema.ParseGroupResource(o.GetName())
+		requests := []reconcile.Request{}
+		for i := range serviceBindings.Items {
+			serviceBinding := &serviceBindings.Items[i]
+			if resourceOf(serviceBinding) == gr {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(serviceBinding)})
+			}
+		}
+		return requests
+	})
+}
+
 func PatchWorkloads() reconcilers.SubReconciler {
 	workloadManager := &reconcilers.ResourceManager{
 		Name: "PatchWorkloads",
@@ -205,8 +1352,12 @@ func PatchWorkloads() reconcilers.SubReconciler {
 		Name:                   "PatchWorkloads",
 		SyncDuringFinalization: true,
 		Sync: func(ctx context.Context, resource *servicebindingv1beta1.ServiceBinding) error {
+			log := logr.FromContextOrDiscard(ctx)
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
 			workloads := RetrieveWorkloads(ctx)
 			projectedWorkloads := RetrieveProjectedWorkloads(ctx)
+			projectionPlans := RetrieveProjectionPlans(ctx)
 
 			if len(workloads) != len(projectedWorkloads) {
 				panic(fmt.Errorf("workloads and projectedWorkloads must have the same number of items"))
@@ -219,6 +1370,10 @@ func PatchWorkloads() reconcilers.SubReconciler {
 					panic(fmt.Errorf("workload and projectedWorkload must have the same uid and resourceVersion"))
 				}
 
+				if i < len(projectionPlans) && projectionPlans[i] != nil && !projectionPlans[i].IsEmpty() {
+					log.Info("projection plan", "workload", client.ObjectKeyFromObject(workload), "plan", projectionPlans[i].Lines())
+				}
+
 				if _, err := workloadManager.Manage(ctx, resource, workload, projectedWorkload); err != nil {
 					if apierrs.IsNotFound(err) {
 						// someone must have deleted the workload while we were operating on it
@@ -227,7 +1382,7 @@ func PatchWorkloads() reconcilers.SubReconciler {
 					if apierrs.IsForbidden(err) {
 						// set False, the operator needs to give access to the resource
 						// see https://servicebinding.io/spec/core/1.0.0/#considerations-for-role-based-access-control-rbac-1
-						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "the controller does not have permission to update the workloads")
+						resource.GetConditionManager().MarkFalse(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected, "WorkloadForbidden", "%s", withMissingWorkloadVerbs(ctx, c, resource, "the controller does not have permission to update the workloads"))
 						return nil
 					}
 					// TODO handle other err cases
@@ -247,6 +1402,20 @@ func PatchWorkloads() reconcilers.SubReconciler {
 
 const WorkloadsStashKey reconcilers.StashKey = "servicebinding.io:workloads"
 
+// withMissingWorkloadVerbs adds the verbs the controller is missing for the resource of the workload, as reported by the
+// ClusterWorkloadResourceMapping for the resource, to the message
+func withMissingWorkloadVerbs(ctx context.Context, c reconcilers.Config, resource *servicebindingv1beta1.ServiceBinding, message string) string {
+	gr := workloadGroupResource(c.RESTMapper(), resource.Spec.Workload)
+	if gr.Empty() {
+		return message
+	}
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+	if err := c.Get(ctx, types.NamespacedName{Name: gr.String()}, mapping); err != nil || len(mapping.Status.MissingVerbs) == 0 {
+		return message
+	}
+	return fmt.Sprintf("%s, missing verbs %s for %s", message, strings.Join(mapping.Status.MissingVerbs, ", "), gr)
+}
+
 func StashWorkloads(ctx context.Context, workloads []runtime.Object) {
 	reconcilers.StashValue(ctx, WorkloadsStashKey, workloads)
 }
@@ -272,3 +1441,45 @@ func RetrieveProjectedWorkloads(ctx context.Context) []runtime.Object {
 	}
 	return nil
 }
+
+const ProjectionPlansStashKey reconcilers.StashKey = "servicebinding.io:projection-plans"
+
+func StashProjectionPlans(ctx context.Context, plans []*projector.Plan) {
+	reconcilers.StashValue(ctx, ProjectionPlansStashKey, plans)
+}
+
+func RetrieveProjectionPlans(ctx context.Context) []*projector.Plan {
+	value := reconcilers.RetrieveValue(ctx, ProjectionPlansStashKey)
+	if plans, ok := value.([]*projector.Plan); ok {
+		return plans
+	}
+	return nil
+}
+
+const PolicyViolationsStashKey reconcilers.StashKey = "servicebinding.io:policy-violations"
+
+func StashPolicyViolations(ctx context.Context, violations field.ErrorList) {
+	reconcilers.StashValue(ctx, PolicyViolationsStashKey, violations)
+}
+
+func RetrievePolicyViolations(ctx context.Context) field.ErrorList {
+	value := reconcilers.RetrieveValue(ctx, PolicyViolationsStashKey)
+	if violations, ok := value.(field.ErrorList); ok {
+		return violations
+	}
+	return nil
+}
+
+const AuthorDeniedStashKey reconcilers.StashKey = "servicebinding.io:author-denied"
+
+func StashAuthorDenied(ctx context.Context, denied bool) {
+	reconcilers.StashValue(ctx, AuthorDeniedStashKey, denied)
+}
+
+func RetrieveAuthorDenied(ctx context.Context) bool {
+	value := reconcilers.RetrieveValue(ctx, AuthorDeniedStashKey)
+	if denied, ok := value.(bool); ok {
+		return denied
+	}
+	return false
+}
@@ -19,6 +19,7 @@ package controllers_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	dieappsv1 "dies.dev/apis/apps/v1"
 	diecorev1 "dies.dev/apis/core/v1"
@@ -26,6 +27,7 @@ import (
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
 	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -42,6 +45,8 @@ import (
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 	"github.com/scothis/servicebinding-runtime/controllers"
 	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+	"github.com/scothis/servicebinding-runtime/projector"
+	"github.com/scothis/servicebinding-runtime/rbac"
 )
 
 func TestServiceBindingReconciler(t *testing.T) {
@@ -76,6 +81,17 @@ func TestServiceBindingReconciler(t *testing.T) {
 			})
 		})
 
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(secretName)
+		}).
+		AddData("type", "postgresql")
+	bindingTypeSchema := dieservicebindingv1beta1.BindingTypeSchemaBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("postgresql")
+		})
+
 	workload := dieappsv1.DeploymentBlank.
 		DieStamp(func(r *appsv1.Deployment) {
 			r.APIVersion = "apps/v1"
@@ -154,15 +170,28 @@ func TestServiceBindingReconciler(t *testing.T) {
 						d.ConditionsDie(
 							dieservicebindingv1beta1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
 							dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1beta1.ServiceBindingConditionBindingValid.True().Reason("ValidatedBinding"),
 							dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+							dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.True().Reason("AdmittedByPolicies"),
 						)
+						d.Type("postgresql")
 						d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("v1")
+							d.Kind("Secret")
+							d.Name(secretName)
+						})
 					}),
 				projectedWorkload,
 			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 			},
 		},
@@ -172,7 +201,12 @@ func TestServiceBindingReconciler(t *testing.T) {
 				serviceBinding,
 				workload,
 			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
 			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
 				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
 			},
 			ExpectEvents: []rtesting.Event{
@@ -199,11 +233,76 @@ func TestServiceBindingReconciler(t *testing.T) {
 						d.ConditionsDie(
 							dieservicebindingv1beta1.ServiceBindingConditionReady.True().Reason("ServiceBound"),
 							dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.True().Reason("ResolvedBindingSecret"),
+							dieservicebindingv1beta1.ServiceBindingConditionBindingValid.True().Reason("ValidatedBinding"),
 							dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.True().Reason("WorkloadProjected"),
+							dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.True().Reason("AdmittedByPolicies"),
 						)
+						d.Type("postgresql")
 						d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
 							d.Name(secretName)
 						})
+						d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+							d.APIVersion("v1")
+							d.Kind("Secret")
+							d.Name(secretName)
+						})
+					}),
+			},
+		},
+		"violates a policy": {
+			Request: req,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}),
+				dieservicebindingv1beta1.ServiceBindingPolicyBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-policy")
+					}).
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingPolicySpecDie) {
+						d.Types("mysql")
+					}),
+				projectedWorkload,
+			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(projectedWorkload, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Deployment %q", "my-workload"),
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "StatusUpdated", "Updated status"),
+			},
+			ExpectUpdates: []client.Object{
+				unprojectedWorkload.(client.Object),
+			},
+			ExpectStatusUpdates: []client.Object{
+				serviceBinding.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Finalizers("servicebinding.io/finalizer")
+					}).
+					StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+						d.ConditionsDie(
+							dieservicebindingv1beta1.ServiceBindingConditionReady.
+								False().
+								Reason("PolicyViolation").
+								Message(`spec.type: Required value: the ServiceBindingPolicy "my-policy" requires one of the types "mysql"`),
+							dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+								Reason("PolicyViolation").
+								Message("the service is not resolved while the ServiceBinding violates a policy"),
+							dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+								Reason("BindingNotResolved").
+								Message("the binding has not been resolved"),
+							dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+								Reason("PolicyViolation").
+								Message("the binding is not projected while the ServiceBinding violates a policy"),
+							dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+								False().
+								Reason("PolicyViolation").
+								Message(`spec.type: Required value: the ServiceBindingPolicy "my-policy" requires one of the types "mysql"`),
+						)
 					}),
 			},
 		},
@@ -243,13 +342,14 @@ func TestServiceBindingReconciler(t *testing.T) {
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.ReconcilerTestCase, c reconcilers.Config) reconcile.Reconciler {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.ServiceBindingReconciler(c)
+		return controllers.ServiceBindingReconciler(c, nil, projector.DefaultTypeProjections())
 	})
 }
 
 func TestResolveBindingSecret(t *testing.T) {
 	namespace := "test-namespace"
 	name := "my-binding"
+	now := metav1.Now()
 
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -282,6 +382,53 @@ func TestResolveBindingSecret(t *testing.T) {
 			"name": secretName,
 		},
 	}
+	fallbackServiceRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("example/v1").
+		Kind("MyProvisionedService").
+		Name("my-fallback-service")
+	fallbackService := provisionedService.DeepCopy()
+	fallbackService.SetName("my-fallback-service")
+	fallbackService.UnstructuredContent()["status"] = map[string]interface{}{
+		"binding": map[string]interface{}{
+			"name": "my-fallback-secret",
+		},
+	}
+	selectorServiceRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("example/v1").
+		Kind("MyProvisionedService").
+		SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+			d.AddMatchLabel("app", "db")
+		})
+	blueService := notProvisionedService.DeepCopy()
+	blueService.SetName("blue")
+	blueService.SetLabels(map[string]string{"app": "db"})
+	blueService.SetCreationTimestamp(metav1.NewTime(now.Add(-time.Hour)))
+	greenService := provisionedService.DeepCopy()
+	greenService.SetName("green")
+	greenService.SetLabels(map[string]string{"app": "db"})
+	greenService.SetCreationTimestamp(now)
+	otherNamespace := "other-namespace"
+	otherServiceRef := serviceRef.
+		Namespace(otherNamespace)
+	otherService := provisionedService.DeepCopy()
+	otherService.SetNamespace(otherNamespace)
+	referenceGrant := dieservicebindingv1beta1.ReferenceGrantBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(otherNamespace)
+			d.Name("my-grant")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ReferenceGrantSpecDie) {
+			d.FromDie(
+				dieservicebindingv1beta1.ReferenceGrantFromBlank.
+					Namespace(namespace),
+			)
+			d.ToDie(
+				dieservicebindingv1beta1.ReferenceGrantToBlank.
+					Group("example").
+					Kind("MyProvisionedService").
+					Name("my-service"),
+			)
+		})
 
 	rts := rtesting.SubReconcilerTests{
 		"resolve direct secret": {
@@ -297,6 +444,7 @@ func TestResolveBindingSecret(t *testing.T) {
 					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
 						d.Name(secretName)
 					})
+					d.Service(directSecretRef.DieReleasePtr())
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
 							True().Reason("ResolvedBindingSecret"),
@@ -319,6 +467,7 @@ func TestResolveBindingSecret(t *testing.T) {
 					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
 						d.Name(secretName)
 					})
+					d.Service(serviceRef.DieReleasePtr())
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
 							True().Reason("ResolvedBindingSecret"),
@@ -341,6 +490,7 @@ func TestResolveBindingSecret(t *testing.T) {
 					d.Service(serviceRef.DieRelease())
 				}).
 				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionReady.
 							Reason("ServiceMissingBinding").
@@ -354,6 +504,123 @@ func TestResolveBindingSecret(t *testing.T) {
 				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
 			},
 		},
+		"fail over to fallback service": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+					d.FallbackServicesDie(fallbackServiceRef)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
+					})
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				notProvisionedService,
+				fallbackService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+					d.FallbackServicesDie(fallbackServiceRef)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name("my-fallback-secret")
+					})
+					d.Service(fallbackServiceRef.DieReleasePtr())
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("ResolvedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(notProvisionedService, serviceBinding, scheme),
+				rtesting.NewTrackRequest(fallbackService, serviceBinding, scheme),
+			},
+		},
+		"preferred service is used over fallback service": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+					d.FallbackServicesDie(fallbackServiceRef)
+				}),
+			GivenObjects: []client.Object{
+				provisionedService,
+				fallbackService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+					d.FallbackServicesDie(fallbackServiceRef)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
+					})
+					d.Service(serviceRef.DieReleasePtr())
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("ResolvedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
+		"select service by label": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(selectorServiceRef.DieRelease())
+				}),
+			GivenObjects: []client.Object{
+				greenService,
+				blueService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(selectorServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
+					})
+					d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+						d.APIVersion("example/v1")
+						d.Kind("MyProvisionedService")
+						d.Name("green")
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("ResolvedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(blueService, serviceBinding, scheme),
+				rtesting.NewTrackRequest(greenService, serviceBinding, scheme),
+			},
+		},
+		"no service matches the selector": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(selectorServiceRef.DieRelease())
+				}),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(selectorServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("ServiceNotFound").
+							Message("the service was not found"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("ServiceNotFound").
+							Message("the service was not found"),
+					)
+				}),
+		},
 		"service not found": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
@@ -382,6 +649,34 @@ func TestResolveBindingSecret(t *testing.T) {
 				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
 			},
 		},
+		"service kind unknown": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "MyProvisionedService", rtesting.InduceFailureOpts{
+					Error: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example", Kind: "MyProvisionedService"}},
+				}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("ServiceKindUnknown").
+							Message("the service kind MyProvisionedService.example is not known to the cluster"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("ServiceKindUnknown").
+							Message("the service kind MyProvisionedService.example is not known to the cluster"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
 		"service forbidden": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
@@ -412,251 +707,2391 @@ func TestResolveBindingSecret(t *testing.T) {
 				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
 			},
 		},
-		"service generic get error": {
+		"service is not resolved while violating a policy": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
 					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
+					})
+					d.Service(serviceRef.DieReleasePtr())
 				}),
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("get", "MyProvisionedService"),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.PolicyViolationsStashKey: field.ErrorList{
+					field.Forbidden(field.NewPath("spec", "service"), `the service kind "MyProvisionedService.example" is not permitted by the ServiceBindingPolicy "my-policy"`),
+				},
 			},
-			ShouldErr: true,
-			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			GivenObjects: []client.Object{
+				provisionedService,
 			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("PolicyViolation").
+							Message("the service is not resolved while the ServiceBinding violates a policy"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("PolicyViolation").
+							Message("the service is not resolved while the ServiceBinding violates a policy"),
+					)
+				}),
 		},
-	}
-
-	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
-		return controllers.ResolveBindingSecret()
-	})
-}
-
-func TestResolveWorkload(t *testing.T) {
-	namespace := "test-namespace"
-	name := "my-binding"
-
-	scheme := runtime.NewScheme()
-	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
-	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
-
-	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name(name)
-		})
-
-	workload := dieappsv1.DeploymentBlank.
-		APIVersion("apps/v1").
-		Kind("Deployment")
-	workload1 := workload.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name("my-workload-1")
-			d.AddLabel("app", "my")
-		})
-	workload2 := workload.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name("my-workload-2")
-			d.AddLabel("app", "my")
-		})
-	workload3 := workload.
-		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-			d.Namespace(namespace)
-			d.Name("not-my-workload")
-			d.AddLabel("app", "not")
-		})
-
-	rts := rtesting.SubReconcilerTests{
-		"resolve named workload": {
+		"service is not resolved while the author lacks access": {
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
 					})
+					d.Service(serviceRef.DieReleasePtr())
 				}),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
+				provisionedService,
 			},
-			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("AuthorForbidden").
+							Message("the service is not resolved while the author of the ServiceBinding lacks access"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("AuthorForbidden").
+							Message("the service is not resolved while the author of the ServiceBinding lacks access"),
+					)
+				}),
+		},
+		"service is not resolved while the author is forbidden to get the service": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the service "my-service"`),
+					)
+				}),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+			GivenObjects: []client.Object{
+				provisionedService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the service "my-service"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the service "my-service"`),
+					)
+				}),
+		},
+		"service in another namespace is granted": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}),
+			GivenObjects: []client.Object{
+				otherService,
+				referenceGrant,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(secretName)
+					})
+					d.Service(otherServiceRef.DieReleasePtr())
+					d.ReferenceGrantDie(func(d *dieservicebindingv1beta1.ServiceBindingReferenceGrantStatusDie) {
+						d.Namespace(otherNamespace)
+						d.Name("my-grant")
+						d.Secret(secretName)
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("ResolvedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(otherService, serviceBinding, scheme),
+			},
+		},
+		"service in another namespace is not granted": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}),
+			GivenObjects: []client.Object{
+				otherService,
+				referenceGrant.
+					SpecDie(func(d *dieservicebindingv1beta1.ReferenceGrantSpecDie) {
+						d.FromDie(
+							dieservicebindingv1beta1.ReferenceGrantFromBlank.
+								Namespace("some-namespace"),
+						)
+					}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("ServiceReferenceNotGranted").
+							Message(`the namespace "other-namespace" does not grant a reference to the service from namespace "test-namespace"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("ServiceReferenceNotGranted").
+							Message(`the namespace "other-namespace" does not grant a reference to the service from namespace "test-namespace"`),
+					)
+				}),
+		},
+		"revoked grant clears the binding": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.Service(otherServiceRef.DieReleasePtr())
+					d.ReferenceGrantDie(func(d *dieservicebindingv1beta1.ServiceBindingReferenceGrantStatusDie) {
+						d.Namespace(otherNamespace)
+						d.Name("my-grant")
+						d.Secret(secretName)
+					})
+				}),
+			GivenObjects: []client.Object{
+				otherService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(otherServiceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("ServiceReferenceNotGranted").
+							Message(`the namespace "other-namespace" does not grant a reference to the service from namespace "test-namespace"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("ServiceReferenceNotGranted").
+							Message(`the namespace "other-namespace" does not grant a reference to the service from namespace "test-namespace"`),
+					)
+				}),
+		},
+		"service generic get error": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "MyProvisionedService"),
+			},
+			ShouldErr: true,
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolveBindingSecret()
+	})
+}
+
+func TestEnforceServiceBindingPolicies(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Type("postgresql")
+			d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("v1")
+				d.Kind("Secret")
+				d.Name("my-secret")
+			})
+			d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name("my-workload")
+			})
+		})
+
+	namespaceObject := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(namespace)
+			d.AddLabel("team", "a")
+		})
+	policy := dieservicebindingv1beta1.ServiceBindingPolicyBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-policy")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingPolicySpecDie) {
+			d.Types("mysql")
+		})
+	violations := field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "type"), `the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+	}
+
+	rts := rtesting.SubReconcilerTests{
+		"without policies": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				namespaceObject,
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+							True().Reason("AdmittedByPolicies"),
+					)
+				}),
+		},
+		"admitted by policy": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				namespaceObject,
+				policy.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingPolicySpecDie) {
+						d.Types("mysql", "postgresql")
+					}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+							True().Reason("AdmittedByPolicies"),
+					)
+				}),
+		},
+		"violates policy": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				namespaceObject,
+				policy,
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("PolicyViolation").
+							Message(`spec.type: Forbidden: the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+						dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+							False().
+							Reason("PolicyViolation").
+							Message(`spec.type: Forbidden: the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+					)
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.PolicyViolationsStashKey: violations,
+			},
+		},
+		"policy selecting other namespaces": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				namespaceObject,
+				policy.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingPolicySpecDie) {
+						d.NamespaceSelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("team", "b")
+						})
+					}),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+							True().Reason("AdmittedByPolicies"),
+					)
+				}),
+		},
+		"list policies error": {
+			Resource: serviceBinding,
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "ServiceBindingPolicyList"),
+			},
+			ShouldErr: true,
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.EnforceServiceBindingPolicies()
+	})
+}
+
+func TestCopyBindingSecret(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+	otherNamespace := "other-namespace"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+	boundServiceBinding := serviceBinding.
+		StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+			d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+				d.Name("my-secret")
+			})
+			d.ReferenceGrantDie(func(d *dieservicebindingv1beta1.ServiceBindingReferenceGrantStatusDie) {
+				d.Namespace(otherNamespace)
+				d.Name("my-grant")
+				d.Secret("my-secret")
+			})
+			d.ConditionsDie(
+				dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+					True().Reason("ResolvedBindingSecret"),
+			)
+		})
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(otherNamespace)
+			d.Name("my-secret")
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com")
+	copiedSecret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(fmt.Sprintf("%s-001", name))
+			d.GenerateName(fmt.Sprintf("%s-", name))
+			d.AddLabel("servicebinding.io/owned", "true")
+			d.AddLabel("servicebinding.io/copied", "true")
+			d.ControlledBy(serviceBinding, scheme)
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com")
+
+	rts := rtesting.SubReconcilerTests{
+		"service in the same namespace is not copied": {
+			Resource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ReferenceGrant(nil)
+				}),
+		},
+		"create copied secret": {
+			Resource: boundServiceBinding,
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("CopiedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Created", "Created Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectCreates: []client.Object{
+				copiedSecret,
+			},
+		},
+		"update copied secret": {
+			Resource: boundServiceBinding,
+			GivenObjects: []client.Object{
+				copiedSecret,
+			},
+			APIGivenObjects: []client.Object{
+				secret.
+					AddData("port", "5432"),
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("CopiedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectUpdates: []client.Object{
+				copiedSecret.
+					AddData("port", "5432"),
+			},
+		},
+		"delete copied secret when the grant is revoked": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				copiedSecret,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(copiedSecret, scheme),
+			},
+		},
+		"binding secret not found": {
+			Resource: boundServiceBinding,
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Binding(nil)
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found in namespace "other-namespace"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found in namespace "other-namespace"`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.CopyBindingSecret()
+	})
+}
+
+func TestResolveBindingConfigMap(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+
+	configMapName := "my-config-map"
+	directConfigMapRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("v1").
+		Kind("ConfigMap").
+		Name(configMapName)
+	directSecretRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("v1").
+		Kind("Secret").
+		Name("my-secret")
+	serviceRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("example/v1").
+		Kind("MyProvisionedService").
+		Name("my-service")
+
+	provisionedService := &unstructured.Unstructured{}
+	provisionedService.SetAPIVersion("example/v1")
+	provisionedService.SetKind("MyProvisionedService")
+	provisionedService.SetNamespace(namespace)
+	provisionedService.SetName("my-service")
+	provisionedService.UnstructuredContent()["status"] = map[string]interface{}{
+		"binding": map[string]interface{}{
+			"name": "my-secret",
+		},
+		"configMap": map[string]interface{}{
+			"name": configMapName,
+		},
+	}
+
+	rts := rtesting.SubReconcilerTests{
+		"resolve direct config map": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(directConfigMapRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(directConfigMapRef.DieReleasePtr())
+				}),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(directConfigMapRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(directConfigMapRef.DieReleasePtr())
+					d.ConfigMapDie(func(d *dieservicebindingv1beta1.ServiceBindingConfigMapReferenceDie) {
+						d.Name(configMapName)
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("ResolvedBindingConfigMap"),
+					)
+				}),
+		},
+		"direct secret has no config map": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(directSecretRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(directSecretRef.DieReleasePtr())
+					d.ConfigMapDie(func(d *dieservicebindingv1beta1.ServiceBindingConfigMapReferenceDie) {
+						d.Name(configMapName)
+					})
+				}),
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(directSecretRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(directSecretRef.DieReleasePtr())
+				}),
+		},
+		"config map alongside binding secret": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name("my-secret")
+					})
+				}),
+			GivenObjects: []client.Object{
+				provisionedService,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name("my-secret")
+					})
+					d.ConfigMapDie(func(d *dieservicebindingv1beta1.ServiceBindingConfigMapReferenceDie) {
+						d.Name(configMapName)
+					})
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
+		"service not found": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "MyProvisionedService", rtesting.InduceFailureOpts{
+					Error: apierrs.NewNotFound(schema.GroupResource{}, "my-service"),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolveBindingConfigMap()
+	})
+}
+
+func TestSynthesizeBindingSecret(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+
+	directSecretRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("v1").
+		Kind("Secret").
+		Name("my-secret")
+	serviceRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("example/v1").
+		Kind("MyService").
+		Name("my-service")
+
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("example/v1")
+	service.SetKind("MyService")
+	service.SetNamespace(namespace)
+	service.SetName("my-service")
+	service.UnstructuredContent()["spec"] = map[string]interface{}{
+		"host": "db.example.com",
+	}
+
+	mapping := dieservicebindingv1beta1.ClusterServiceResourceMappingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("myservices.example")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingSpecDie) {
+			d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingTemplateDie) {
+				d.DataDie(
+					dieservicebindingv1beta1.ClusterServiceResourceMappingDataBlank.
+						Key("type").
+						Value("postgresql"),
+					dieservicebindingv1beta1.ClusterServiceResourceMappingDataBlank.
+						Key("host").
+						Value("{{ .spec.host }}"),
+				)
+			})
+		})
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(fmt.Sprintf("%s-001", name))
+			d.GenerateName(fmt.Sprintf("%s-", name))
+			d.AddLabel("servicebinding.io/owned", "true")
+			d.ControlledBy(serviceBinding, scheme)
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com")
+
+	rts := rtesting.SubReconcilerTests{
+		"direct secret is not synthesized": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(directSecretRef.DieRelease())
+				}),
+		},
+		"unmapped service is not synthesized": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				service,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+			},
+		},
+		"create synthesized secret": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				service,
+				mapping,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("SynthesizedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Created", "Created Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectCreates: []client.Object{
+				secret,
+			},
+		},
+		"synthesized secret in sync": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				service,
+				mapping,
+				secret,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("SynthesizedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+			},
+		},
+		"update synthesized secret": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				service,
+				mapping,
+				secret.
+					AddData("host", "stale.example.com"),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("SynthesizedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Updated", "Updated Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectUpdates: []client.Object{
+				secret,
+			},
+		},
+		"template failed": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+				}),
+			GivenObjects: []client.Object{
+				service,
+				mapping.
+					SpecDie(func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingSpecDie) {
+						d.VersionsDie("*", func(d *dieservicebindingv1beta1.ClusterServiceResourceMappingTemplateDie) {
+							d.DataDie(
+								dieservicebindingv1beta1.ClusterServiceResourceMappingDataBlank.
+									Key("port").
+									Value("{{ .spec.port }}"),
+							)
+						})
+					}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Service(serviceRef.DieRelease())
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Service(serviceRef.DieReleasePtr())
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("BindingSecretTemplateFailed").
+							Message(`unable to render binding secret: entry "port": template: port:1:8: executing "port" at <.spec.port>: map has no entry for key "port"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("BindingSecretTemplateFailed").
+							Message(`unable to render binding secret: entry "port": template: port:1:8: executing "port" at <.spec.port>: map has no entry for key "port"`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyService"}, meta.RESTScopeNamespace)
+		return controllers.SynthesizeBindingSecret()
+	})
+}
+
+func TestTransformBindingSecret(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	transform := &servicebindingv1beta1.ServiceBindingTransform{
+		Entries: []servicebindingv1beta1.ServiceBindingTransformEntry{
+			{Key: "uri", Value: "postgresql://{{ .host }}:{{ .port }}"},
+		},
+		Renames: []servicebindingv1beta1.ServiceBindingTransformRename{
+			{From: "user", To: "username"},
+		},
+		Drop: []string{"port"},
+	}
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+	boundServiceBinding := serviceBinding.
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Transform(transform)
+		}).
+		StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+			d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+				d.Name("my-secret")
+			})
+			d.ConditionsDie(
+				dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+					True().Reason("ResolvedBindingSecret"),
+			)
+		})
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-secret")
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com").
+		AddData("port", "5432").
+		AddData("user", "admin")
+	transformedSecret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(fmt.Sprintf("%s-001", name))
+			d.GenerateName(fmt.Sprintf("%s-", name))
+			d.AddLabel("servicebinding.io/owned", "true")
+			d.AddLabel("servicebinding.io/transformed", "true")
+			d.ControlledBy(serviceBinding, scheme)
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com").
+		AddData("username", "admin").
+		AddData("uri", "postgresql://db.example.com:5432")
+
+	rts := rtesting.SubReconcilerTests{
+		"without transform the binding secret is projected": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Transform(nil)
+				}),
+		},
+		"create transformed secret": {
+			Resource: boundServiceBinding,
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("TransformedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Created", "Created Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectCreates: []client.Object{
+				transformedSecret,
+			},
+		},
+		"transformed secret in sync": {
+			Resource: boundServiceBinding,
+			GivenObjects: []client.Object{
+				transformedSecret,
+			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+						d.Name(fmt.Sprintf("%s-001", name))
+					})
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().Reason("TransformedBindingSecret"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"delete transformed secret when the transform is removed": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Transform(nil)
+				}),
+			GivenObjects: []client.Object{
+				transformedSecret,
+			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", fmt.Sprintf("%s-001", name)),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(transformedSecret, scheme),
+			},
+		},
+		"binding secret not found": {
+			Resource: boundServiceBinding,
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Binding(nil)
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"transform failed": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Transform(&servicebindingv1beta1.ServiceBindingTransform{
+						Entries: []servicebindingv1beta1.ServiceBindingTransformEntry{
+							{Key: "database", Value: "{{ .database }}"},
+						},
+					})
+				}),
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Transform(&servicebindingv1beta1.ServiceBindingTransform{
+						Entries: []servicebindingv1beta1.ServiceBindingTransformEntry{
+							{Key: "database", Value: "{{ .database }}"},
+						},
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Binding(nil)
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("BindingTransformFailed").
+							Message(`unable to transform binding secret: entry "database": template: database:1:3: executing "database" at <.database>: map has no entry for key "database"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("BindingTransformFailed").
+							Message(`unable to transform binding secret: entry "database": template: database:1:3: executing "database" at <.database>: map has no entry for key "database"`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.TransformBindingSecret()
+	})
+}
+
+func TestValidateBinding(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+	boundServiceBinding := serviceBinding.
+		StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+			d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+				d.Name("my-secret")
+			})
+		})
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-secret")
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com")
+	configMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-config-map")
+		}).
+		AddData("type", "postgresql").
+		AddData("host", "db.example.com")
+	bindingTypeSchema := dieservicebindingv1beta1.BindingTypeSchemaBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("postgresql")
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.BindingTypeSchemaSpecDie) {
+			d.RequiredKeys("host", "port", "database")
+			d.OptionalKeys("username", "password")
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"binding not resolved": {
+			Resource: serviceBinding,
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Unknown().Reason("BindingNotResolved").
+							Message("the binding has not been resolved"),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							Unknown().Reason("BindingNotResolved").
+							Message("the binding has not been resolved"),
+					)
+				}),
+		},
+		"valid binding secret": {
+			Resource: boundServiceBinding,
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("postgresql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("ValidatedBinding"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
+			},
+		},
+		"valid config map": {
+			Resource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConfigMapDie(func(d *dieservicebindingv1beta1.ServiceBindingConfigMapReferenceDie) {
+						d.Name("my-config-map")
+					})
+				}),
+			GivenObjects: []client.Object{
+				configMap,
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConfigMapDie(func(d *dieservicebindingv1beta1.ServiceBindingConfigMapReferenceDie) {
+						d.Name("my-config-map")
+					})
+					d.Type("postgresql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("ValidatedBinding"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(configMap, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
+			},
+		},
+		"binding secret not found": {
+			Resource: boundServiceBinding,
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Unknown().Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							Unknown().Reason("BindingSecretNotFound").
+							Message(`the binding secret "my-secret" was not found`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"missing type": {
+			Resource: boundServiceBinding,
+			APIGivenObjects: []client.Object{
+				secret.
+					DieStamp(func(r *corev1.Secret) {
+						delete(r.Data, "type")
+					}),
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().Reason("MissingType").
+							Message(`the binding must contain a "type" entry`),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							False().Reason("MissingType").
+							Message(`the binding must contain a "type" entry`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"type from spec": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+				}),
+			APIGivenObjects: []client.Object{
+				secret.
+					DieStamp(func(r *corev1.Secret) {
+						delete(r.Data, "type")
+					}),
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("mysql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("ValidatedBinding"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("mysql")
+					}), serviceBinding, scheme),
+			},
+		},
+		"provider from the binding secret": {
+			Resource: boundServiceBinding,
+			APIGivenObjects: []client.Object{
+				secret.
+					AddData("provider", "bitnami"),
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("postgresql")
+					d.Provider("bitnami")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("ValidatedBinding"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
+			},
+		},
+		"spec overrides conflicting type and provider": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+					d.Provider("example")
+				}),
+			APIGivenObjects: []client.Object{
+				secret.
+					AddData("provider", "bitnami"),
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+					d.Provider("example")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("mysql")
+					d.Provider("example")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("OverriddenBySpec").
+							Message(`the type "postgresql" of the binding is overridden by "mysql" from the spec; the provider "bitnami" of the binding is overridden by "example" from the spec`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("mysql")
+					}), serviceBinding, scheme),
+			},
+		},
+		"binding type violates policy": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+				}),
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(namespace)
+					}),
+				dieservicebindingv1beta1.ServiceBindingPolicyBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-policy")
+					}).
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingPolicySpecDie) {
+						d.Types("mysql")
+					}),
+			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.Type("mysql")
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("mysql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("PolicyViolation").
+							Message(`status.type: Forbidden: the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("OverriddenBySpec").
+							Message(`the type "postgresql" of the binding is overridden by "mysql" from the spec`),
+						dieservicebindingv1beta1.ServiceBindingConditionPolicyCompliant.
+							False().
+							Reason("PolicyViolation").
+							Message(`status.type: Forbidden: the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("mysql")
+					}), serviceBinding, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.PolicyViolationsStashKey: field.ErrorList{
+					field.Forbidden(field.NewPath("status", "type"), `the type "postgresql" is not permitted by the ServiceBindingPolicy "my-policy"`),
+				},
+			},
+		},
+		"missing env keys": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.EnvDie("host", func(d *dieservicebindingv1beta1.EnvMappingDie) {
+						d.Name("HOST")
+					})
+					d.EnvDie("username", func(d *dieservicebindingv1beta1.EnvMappingDie) {
+						d.Name("USERNAME")
+					})
+				}),
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.EnvDie("host", func(d *dieservicebindingv1beta1.EnvMappingDie) {
+						d.Name("HOST")
+					})
+					d.EnvDie("username", func(d *dieservicebindingv1beta1.EnvMappingDie) {
+						d.Name("USERNAME")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("postgresql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().Reason("MissingEnvKeys").
+							Message(`the binding does not contain the entries ["username"] referenced by spec.env`),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							False().Reason("MissingEnvKeys").
+							Message(`the binding does not contain the entries ["username"] referenced by spec.env`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+			},
+		},
+		"missing keys required by the binding type schema": {
+			Resource: boundServiceBinding,
+			GivenObjects: []client.Object{
+				bindingTypeSchema,
+			},
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("postgresql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().Reason("MissingRequiredKeys").
+							Message(`the binding of type "postgresql" does not contain the required entries ["database" "port"]`),
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							False().Reason("MissingRequiredKeys").
+							Message(`the binding of type "postgresql" does not contain the required entries ["database" "port"]`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
+			},
+		},
+		"satisfies the binding type schema": {
+			Resource: boundServiceBinding,
+			GivenObjects: []client.Object{
+				bindingTypeSchema,
+			},
+			APIGivenObjects: []client.Object{
+				secret.
+					AddData("port", "5432").
+					AddData("database", "app"),
+			},
+			ExpectResource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.Type("postgresql")
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionBindingValid.
+							True().Reason("ValidatedBinding"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(bindingTypeSchema, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ValidateBinding()
+	})
+}
+
+func TestResolveAdditionalServices(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+
+	directSecretRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("v1").
+		Kind("Secret").
+		Name("my-secret")
+	replicaSecretRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("v1").
+		Kind("Secret").
+		Name("my-replica-secret")
+	serviceRef := dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+		APIVersion("example/v1").
+		Kind("MyProvisionedService").
+		Name("my-service")
+
+	provisionedService := &unstructured.Unstructured{}
+	provisionedService.SetAPIVersion("example/v1")
+	provisionedService.SetKind("MyProvisionedService")
+	provisionedService.SetNamespace(namespace)
+	provisionedService.SetName("my-service")
+
+	secret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-secret")
+		}).
+		AddData("host", "primary.example.com")
+	replicaSecret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-replica-secret")
+		}).
+		AddData("host", "replica.example.com")
+
+	boundServiceBinding := serviceBinding.
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Service(directSecretRef.DieRelease())
+		}).
+		StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+			d.Service(directSecretRef.DieReleasePtr())
+			d.BindingDie(func(d *dieservicebindingv1beta1.ServiceBindingSecretReferenceDie) {
+				d.Name("my-secret")
+			})
+			d.ConditionsDie(
+				dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+					True().Reason("ResolvedBindingSecret"),
+			)
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"no additional services": {
+			Resource: boundServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceStatusBlank.
+							Path("replica"),
+					)
+				}),
+			ExpectResource: boundServiceBinding,
+		},
+		"project additional service into path": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(replicaSecretRef.DieRelease()).
+							Path("replica"),
+					)
+				}),
+			APIGivenObjects: []client.Object{
+				secret,
+				replicaSecret,
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(replicaSecretRef.DieRelease()).
+							Path("replica"),
+					)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceStatusBlank.
+							Path("replica").
+							Service(replicaSecretRef.DieReleasePtr()).
+							Binding(&servicebindingv1beta1.ServiceBindingSecretReference{Name: "my-replica-secret"}).
+							Keys("host").
+							ConditionsDie(
+								dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+									True().Reason("ResolvedBindingSecret"),
+							),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(replicaSecret, serviceBinding, scheme),
+			},
+		},
+		"conflicting entries are not projected": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(replicaSecretRef.DieRelease()),
+					)
+				}),
+			APIGivenObjects: []client.Object{
+				secret,
+				replicaSecret,
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(replicaSecretRef.DieRelease()),
+					)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceStatusBlank.
+							Service(replicaSecretRef.DieReleasePtr()).
+							ConditionsDie(
+								dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+									False().Reason("BindingKeyConflict").
+									Message(`the entry "host" conflicts with an entry of another service`),
+							),
+					)
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().Reason("AdditionalServiceNotAvailable").
+							Message(`additional service 0: the entry "host" conflicts with an entry of another service`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().Reason("AdditionalServiceNotAvailable").
+							Message(`additional service 0: the entry "host" conflicts with an entry of another service`),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(replicaSecret, serviceBinding, scheme),
+			},
+		},
+		"additional service not found": {
+			Resource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(serviceRef.DieRelease()).
+							Path("replica"),
+					)
+				}),
+			APIGivenObjects: []client.Object{
+				secret,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "MyProvisionedService", rtesting.InduceFailureOpts{
+					Error: apierrs.NewNotFound(schema.GroupResource{}, "my-service"),
+				}),
+			},
+			ExpectResource: boundServiceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceReferenceBlank.
+							Service(serviceRef.DieRelease()).
+							Path("replica"),
+					)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.AdditionalServicesDie(
+						dieservicebindingv1beta1.ServiceBindingAdditionalServiceStatusBlank.
+							Path("replica").
+							ConditionsDie(
+								dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+									Unknown().Reason("ServiceNotFound").
+									Message("the service was not found"),
+							),
+					)
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Unknown().Reason("AdditionalServiceNotAvailable").
+							Message("additional service 0: the service was not found"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							Unknown().Reason("AdditionalServiceNotAvailable").
+							Message("additional service 0: the service was not found"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(secret, serviceBinding, scheme),
+				rtesting.NewTrackRequest(provisionedService, serviceBinding, scheme),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolveAdditionalServices()
+	})
+}
+
+func TestResolveWorkload(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+		})
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment")
+	workload1 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload-1")
+			d.AddLabel("app", "my")
+		})
+	workload2 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload-2")
+			d.AddLabel("app", "my")
+		})
+	workload3 := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("not-my-workload")
+			d.AddLabel("app", "not")
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"resolve named workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload1.DieReleaseUnstructured(),
+				},
+			},
+		},
+		"resolve named workload not found": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}),
+			ExpectedResult: reconcile.Result{Requeue: true},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("WorkloadNotFound").Message("the workload was not found"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							Reason("WorkloadNotFound").Message("the workload was not found"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+		},
+		"resolve named workload kind unknown": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}),
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "Deployment", rtesting.InduceFailureOpts{
+					Error: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"}},
+				}),
+			},
+			ExpectedResult: reconcile.Result{Requeue: true},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Reason("WorkloadKindUnknown").Message("the workload kind Deployment.apps is not known to the cluster"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							Reason("WorkloadKindUnknown").Message("the workload kind Deployment.apps is not known to the cluster"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+		},
+		"resolve named workload forbidden": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("get", "Deployment", rtesting.InduceFailureOpts{
+					Error: apierrs.NewForbidden(schema.GroupResource{}, "my-workload-1", fmt.Errorf("test forbidden")),
+				}),
+			},
+			ExpectedResult: reconcile.Result{Requeue: true},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to get the workload"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to get the workload"),
+					)
+				}),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			},
+		},
+		"resolve selected workload": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
 			},
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.WorkloadsStashKey: []runtime.Object{
 					workload1.DieReleaseUnstructured(),
+					workload2.DieReleaseUnstructured(),
 				},
 			},
 		},
-		"resolve named workload not found": {
+		"resolve selected workload not found": {
+			GivenObjects: []client.Object{},
 			Resource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
 					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
 						d.APIVersion("apps/v1")
 						d.Kind("Deployment")
-						d.Name("my-workload-1")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
+					})
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{},
+			},
+		},
+		"resolve selected workload forbidden": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
 					})
 				}),
+			GivenObjects: []client.Object{
+				workload1,
+				workload2,
+				workload3,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("list", "DeploymentList", rtesting.InduceFailureOpts{
+					Error: apierrs.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("test forbidden")),
+				}),
+			},
 			ExpectedResult: reconcile.Result{Requeue: true},
 			ExpectResource: serviceBinding.
 				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
 					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
 						d.APIVersion("apps/v1")
 						d.Kind("Deployment")
-						d.Name("my-workload-1")
+						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
+							d.AddMatchLabel("app", "my")
+						})
 					})
 				}).
 				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionReady.
-							Reason("WorkloadNotFound").Message("the workload was not found"),
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to list the workloads"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to list the workloads"),
+					)
+				}),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ResolveWorkloads()
+	})
+}
+
+func TestAuthorizeServiceBindingAuthor(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-binding"
+	secretName := "my-secret"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.AddAnnotation(servicebindingv1beta1.ServiceBindingAuthorAnnotation, `{"username":"alice","groups":["system:authenticated"]}`)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Name(name)
+			d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("example/v1")
+				d.Kind("MyProvisionedService")
+				d.Name("my-service")
+			})
+			d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name("my-workload")
+			})
+		})
+
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("example/v1")
+	service.SetKind("MyProvisionedService")
+	service.SetNamespace(namespace)
+	service.SetName("my-service")
+	service.UnstructuredContent()["status"] = map[string]interface{}{
+		"binding": map[string]interface{}{
+			"name": secretName,
+		},
+	}
+
+	workload := dieappsv1.DeploymentBlank.
+		DieStamp(func(r *appsv1.Deployment) {
+			r.APIVersion = "apps/v1"
+			r.Kind = "Deployment"
+		}).
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload")
+		})
+
+	controllerAuthor := "system:serviceaccount:servicebinding-runtime-system:servicebinding-runtime-controller-manager"
+	policy := dieservicebindingv1beta1.BindingPolicyBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-policy")
+			d.UID("3b5a9e5c-3c2d-4b9e-8f6a-2f0c6a1d7e41")
+			d.AddAnnotation(servicebindingv1beta1.ServiceBindingAuthorAnnotation, `{"username":"bob","groups":["system:authenticated"]}`)
+		})
+	policyServiceBinding := serviceBinding.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(servicebindingv1beta1.ServiceBindingAuthorAnnotation, fmt.Sprintf(`{"username":%q,"groups":["system:authenticated"]}`, controllerAuthor))
+			d.ControlledBy(policy, scheme)
+		})
+
+	getService := authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "get",
+		Group:     "example",
+		Resource:  "myprovisionedservices",
+		Name:      "my-service",
+	}
+	getSecret := authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "get",
+		Resource:  "secrets",
+		Name:      secretName,
+	}
+	updateWorkload := authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "update",
+		Group:     "apps",
+		Resource:  "deployments",
+		Name:      "my-workload",
+	}
+
+	rts := rtesting.SubReconcilerTests{
+		"author is not checked": {
+			Metadata: map[string]interface{}{
+				"DisableAuthorAccess": true,
+			},
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+		},
+		"author is permitted": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				reviewSubjectAccessReviews(),
+				allowSubjectAccessReviewFor("alice", getService),
+				allowSubjectAccessReviewFor("alice", getSecret),
+				allowSubjectAccessReviewFor("alice", updateWorkload),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor("alice", getService),
+				subjectAccessReviewFor("alice", getSecret),
+				subjectAccessReviewFor("alice", updateWorkload),
+			},
+		},
+		"author is not recorded": {
+			Resource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Annotations(nil)
+				}),
+			ExpectResource: serviceBinding.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Annotations(nil)
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorUnknown").
+							Message("the author of the ServiceBinding is not recorded"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("AuthorUnknown").
+							Message("the author of the ServiceBinding is not recorded"),
+					)
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+		},
+		"author is forbidden to get the service": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				reviewSubjectAccessReviews(),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor("alice", getService),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the service "my-service"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the service "my-service"`),
+					)
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+		},
+		"author is forbidden to get the binding secret": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				reviewSubjectAccessReviews(),
+				allowSubjectAccessReviewFor("alice", getService),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor("alice", getService),
+				subjectAccessReviewFor("alice", getSecret),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the binding secret "my-secret"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to get the binding secret "my-secret"`),
+					)
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+		},
+		"author is forbidden to update the workload": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				reviewSubjectAccessReviews(),
+				allowSubjectAccessReviewFor("alice", getService),
+				allowSubjectAccessReviewFor("alice", getSecret),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor("alice", getService),
+				subjectAccessReviewFor("alice", getSecret),
+				subjectAccessReviewFor("alice", updateWorkload),
+			},
+			ExpectResource: serviceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to update the workload "my-workload"`),
 						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
-							Reason("WorkloadNotFound").Message("the workload was not found"),
+							False().
+							Reason("AuthorForbidden").
+							Message(`the author "alice" does not have permission to update the workload "my-workload"`),
 					)
 				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+		},
+		"author of the BindingPolicy is permitted": {
+			Resource: policyServiceBinding,
+			GivenObjects: []client.Object{
+				policy,
+				service,
+				workload,
+			},
 			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+				rtesting.NewTrackRequest(policy, serviceBinding, scheme),
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				reviewSubjectAccessReviews(),
+				allowSubjectAccessReviewFor(controllerAuthor, getService),
+				allowSubjectAccessReviewFor(controllerAuthor, getSecret),
+				allowSubjectAccessReviewFor(controllerAuthor, updateWorkload),
+				allowSubjectAccessReviewFor("bob", getService),
+				allowSubjectAccessReviewFor("bob", getSecret),
+				allowSubjectAccessReviewFor("bob", updateWorkload),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor(controllerAuthor, getService),
+				subjectAccessReviewFor(controllerAuthor, getSecret),
+				subjectAccessReviewFor(controllerAuthor, updateWorkload),
+				subjectAccessReviewFor("bob", getService),
+				subjectAccessReviewFor("bob", getSecret),
+				subjectAccessReviewFor("bob", updateWorkload),
 			},
 		},
-		"resolve named workload forbidden": {
-			Resource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
-					})
-				}),
+		"author of the BindingPolicy is forbidden to get the binding secret": {
+			Resource: policyServiceBinding,
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
+				policy,
+				service,
+				workload,
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(policy, serviceBinding, scheme),
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
 			},
 			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("get", "Deployment", rtesting.InduceFailureOpts{
-					Error: apierrs.NewForbidden(schema.GroupResource{}, "my-workload-1", fmt.Errorf("test forbidden")),
-				}),
+				reviewSubjectAccessReviews(),
+				allowSubjectAccessReviewFor(controllerAuthor, getService),
+				allowSubjectAccessReviewFor(controllerAuthor, getSecret),
+				allowSubjectAccessReviewFor(controllerAuthor, updateWorkload),
+				allowSubjectAccessReviewFor("bob", getService),
 			},
-			ExpectedResult: reconcile.Result{Requeue: true},
-			ExpectResource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.Name("my-workload-1")
-					})
-				}).
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor(controllerAuthor, getService),
+				subjectAccessReviewFor(controllerAuthor, getSecret),
+				subjectAccessReviewFor(controllerAuthor, updateWorkload),
+				subjectAccessReviewFor("bob", getService),
+				subjectAccessReviewFor("bob", getSecret),
+			},
+			ExpectResource: policyServiceBinding.
 				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionReady.
 							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to get the workload"),
-						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							Reason("AuthorForbidden").
+							Message(`the author "bob" does not have permission to get the binding secret "my-secret"`),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
 							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to get the workload"),
+							Reason("AuthorForbidden").
+							Message(`the author "bob" does not have permission to get the binding secret "my-secret"`),
 					)
 				}),
-			ExpectTracks: []rtesting.TrackRequest{
-				rtesting.NewTrackRequest(workload1, serviceBinding, scheme),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
 			},
 		},
-		"resolve selected workload": {
-			Resource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
-					})
-				}),
+		"author of the BindingPolicy is not recorded": {
+			Resource: policyServiceBinding,
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
+				policy.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Annotations(nil)
+					}),
+				service,
+				workload,
 			},
-			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-				controllers.WorkloadsStashKey: []runtime.Object{
-					workload1.DieReleaseUnstructured(),
-					workload2.DieReleaseUnstructured(),
-				},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(policy, serviceBinding, scheme),
 			},
-		},
-		"resolve selected workload not found": {
-			GivenObjects: []client.Object{},
-			Resource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
-					})
+			ExpectResource: policyServiceBinding.
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("AuthorUnknown").
+							Message(`the author of the BindingPolicy "my-policy" is not recorded`),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("AuthorUnknown").
+							Message(`the author of the BindingPolicy "my-policy" is not recorded`),
+					)
 				}),
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
-				controllers.WorkloadsStashKey: []runtime.Object{},
+				controllers.AuthorDeniedStashKey: true,
 			},
 		},
-		"resolve selected workload forbidden": {
+		"author of the bindings annotation of the workload is not recorded": {
 			Resource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
-					})
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(servicebindingv1beta1.ServiceBindingAuthorAnnotation, fmt.Sprintf(`{"username":%q,"groups":["system:authenticated"]}`, controllerAuthor))
+					d.ControlledBy(workload, scheme)
 				}),
 			GivenObjects: []client.Object{
-				workload1,
-				workload2,
-				workload3,
-			},
-			WithReactors: []rtesting.ReactionFunc{
-				rtesting.InduceFailure("list", "DeploymentList", rtesting.InduceFailureOpts{
-					Error: apierrs.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("test forbidden")),
-				}),
+				service,
+				workload,
 			},
-			ExpectedResult: reconcile.Result{Requeue: true},
 			ExpectResource: serviceBinding.
-				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
-					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
-						d.APIVersion("apps/v1")
-						d.Kind("Deployment")
-						d.SelectorDie(func(d *diemetav1.LabelSelectorDie) {
-							d.AddMatchLabel("app", "my")
-						})
-					})
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(servicebindingv1beta1.ServiceBindingAuthorAnnotation, fmt.Sprintf(`{"username":%q,"groups":["system:authenticated"]}`, controllerAuthor))
+					d.ControlledBy(workload, scheme)
 				}).
 				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
 					d.ConditionsDie(
 						dieservicebindingv1beta1.ServiceBindingConditionReady.
 							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to list the workloads"),
+							Reason("AuthorUnknown").
+							Message(`the author of the bindings annotation of the workload "my-workload" is not recorded`),
 						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
 							False().
-							Reason("WorkloadForbidden").
-							Message("the controller does not have permission to list the workloads"),
+							Reason("AuthorUnknown").
+							Message(`the author of the bindings annotation of the workload "my-workload" is not recorded`),
 					)
 				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.AuthorDeniedStashKey: true,
+			},
+		},
+		"review of the author failed": {
+			Resource: serviceBinding,
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("create", "SubjectAccessReview"),
+			},
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(service, serviceBinding, scheme),
+				rtesting.NewTrackRequest(workload, serviceBinding, scheme),
+			},
+			ExpectCreates: []client.Object{
+				subjectAccessReviewFor("alice", getService),
+			},
+			ShouldErr: true,
+		},
+		"author is not checked while violating a policy": {
+			Resource: serviceBinding,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.PolicyViolationsStashKey: field.ErrorList{
+					field.Forbidden(field.NewPath("spec", "service"), `the service kind "MyProvisionedService.example" is not permitted by the ServiceBindingPolicy "my-policy"`),
+				},
+			},
+			GivenObjects: []client.Object{
+				service,
+				workload,
+			},
 		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
-		return controllers.ResolveWorkloads()
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "example", Version: "v1", Kind: "MyProvisionedService"}, meta.RESTScopeNamespace)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+		if disabled, _ := rtc.Metadata["DisableAuthorAccess"].(bool); disabled {
+			return controllers.AuthorizeServiceBindingAuthor(nil)
+		}
+		return controllers.AuthorizeServiceBindingAuthor(rbac.NewSubjectAccessChecker(c, 0))
 	})
 }
 
+func subjectAccessReviewFor(user string, attributes authorizationv1.ResourceAttributes) *authorizationv1.SubjectAccessReview {
+	return &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+			User:               user,
+			Groups:             []string{"system:authenticated"},
+		},
+	}
+}
+
+// reviewSubjectAccessReviews names the SubjectAccessReviews being created, which the fake client requires in order to
+// accept the review. Reviews are not persisted by the API Server, so their name is otherwise not meaningful.
+func reviewSubjectAccessReviews() rtesting.ReactionFunc {
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		r := action.GetResource()
+		if r.Group != "authorization.k8s.io" || r.Resource != "SubjectAccessReview" || r.Version != "v1" || action.GetVerb() != "create" {
+			// ignore, not creating a SubjectAccessReview
+			return false, nil, nil
+		}
+		sar := action.(rtesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.GenerateName = "review-"
+		return false, nil, nil
+	}
+}
+
+func allowSubjectAccessReviewFor(user string, attributes authorizationv1.ResourceAttributes) rtesting.ReactionFunc {
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		r := action.GetResource()
+		if r.Group != "authorization.k8s.io" || r.Resource != "SubjectAccessReview" || r.Version != "v1" || action.GetVerb() != "create" {
+			// ignore, not creating a SubjectAccessReview
+			return false, nil, nil
+		}
+		sar := action.(rtesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		if ra := sar.Spec.ResourceAttributes; ra != nil && sar.Spec.User == user && *ra == attributes {
+			sar.Status.Allowed = true
+			return true, sar, nil
+		}
+		return false, nil, nil
+	}
+}
+
 func TestProjectBinding(t *testing.T) {
 	namespace := "test-namespace"
 	name := "my-binding"
@@ -799,12 +3234,53 @@ func TestProjectBinding(t *testing.T) {
 				},
 			},
 		},
+		"unproject workload when the author is denied": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					projectedWorkload.DieReleaseUnstructured(),
+				},
+				controllers.AuthorDeniedStashKey: true,
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload-1")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							Unknown().
+							Reason("AuthorForbidden").
+							Message("the binding is not projected while the author of the ServiceBinding lacks access"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							Unknown().
+							Reason("AuthorForbidden").
+							Message("the binding is not projected while the author of the ServiceBinding lacks access"),
+					)
+				}),
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					unprojectedWorkload,
+				},
+			},
+		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.ProjectBinding()
+		return controllers.ProjectBinding(projector.DefaultTypeProjections())
 	})
 }
 
@@ -996,6 +3472,77 @@ func TestPatchWorkloads(t *testing.T) {
 					}).DieReleaseUnstructured().(client.Object),
 			},
 		},
+		"update workload forbidden, reporting the verbs missing for the resource": {
+			Resource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload")
+					})
+				}),
+			GivenObjects: []client.Object{
+				workload,
+				dieservicebindingv1beta1.ClusterWorkloadResourceMappingBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("deployments.apps")
+					}).
+					StatusDie(func(d *dieservicebindingv1beta1.ClusterWorkloadResourceMappingStatusDie) {
+						d.MissingVerbs("update", "patch")
+					}),
+			},
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WorkloadsStashKey: []runtime.Object{
+					workload.DieReleaseUnstructured(),
+				},
+				controllers.ProjectedWorkloadsStashKey: []runtime.Object{
+					workload.
+						SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+							// not something a binding would ever project, but good enough for a test
+							d.Paused(true)
+						}).
+						DieReleaseUnstructured(),
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				rtesting.InduceFailure("update", "Deployment", rtesting.InduceFailureOpts{
+					Error: apierrs.NewForbidden(schema.GroupResource{}, "", fmt.Errorf("test forbidden")),
+				}),
+			},
+			ExpectResource: serviceBinding.
+				SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name("my-workload")
+					})
+				}).
+				StatusDie(func(d *dieservicebindingv1beta1.ServiceBindingStatusDie) {
+					d.ConditionsDie(
+						dieservicebindingv1beta1.ServiceBindingConditionReady.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to update the workloads, missing verbs update, patch for deployments.apps"),
+						dieservicebindingv1beta1.ServiceBindingConditionServiceAvailable.
+							True().
+							Reason("ResolvedBindingSecret"),
+						dieservicebindingv1beta1.ServiceBindingConditionWorkloadProjected.
+							False().
+							Reason("WorkloadForbidden").
+							Message("the controller does not have permission to update the workloads, missing verbs update, patch for deployments.apps"),
+					)
+				}),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(serviceBinding, scheme, corev1.EventTypeWarning, "UpdateFailed", "Failed to update Deployment %q: forbidden: test forbidden", "my-workload"),
+			},
+			ExpectUpdates: []client.Object{
+				workload.
+					SpecDie(func(d *dieappsv1.DeploymentSpecDie) {
+						// not something a binding would ever project, but good enough for a test
+						d.Paused(true)
+					}).DieReleaseUnstructured().(client.Object),
+			},
+		},
 		"require same number of workloads and projected workloads": {
 			Resource: serviceBinding,
 			GivenObjects: []client.Object{
@@ -1052,6 +3599,8 @@ func TestPatchWorkloads(t *testing.T) {
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
+		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 		return controllers.PatchWorkloads()
 	})
 }
@@ -18,13 +18,20 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	"github.com/vmware-labs/reconciler-runtime/tracker"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -36,7 +43,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -48,6 +57,10 @@ import (
 
 //+kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch
 
 // AdmissionProjector reconciles a MutatingWebhookConfiguration object
 func AdmissionProjectorReconciler(c reconcilers.Config, name string, accessChecker rbac.AccessChecker) *reconcilers.AggregateReconciler {
@@ -104,13 +117,15 @@ func AdmissionProjectorReconciler(c reconcilers.Config, name string, accessCheck
 			}); err != nil {
 				return err
 			}
+			watchPermissions(bldr, req, accessChecker)
+			watchCustomResourceDefinitions(bldr, req, mgr.GetRESTMapper())
 			return nil
 		},
 		Config: c,
 	}
 }
 
-func AdmissionProjectorWebhook(c reconcilers.Config) *reconcilers.AdmissionWebhookAdapter {
+func AdmissionProjectorWebhook(c reconcilers.Config, typeProjections map[string]projector.TypeProjection) *reconcilers.AdmissionWebhookAdapter {
 	return &reconcilers.AdmissionWebhookAdapter{
 		Name: "AdmissionProjectorWebhook",
 		Type: &unstructured.Unstructured{},
@@ -149,13 +164,34 @@ func AdmissionProjectorWebhook(c reconcilers.Config) *reconcilers.AdmissionWebho
 				}
 
 				// project active bindings into workload
-				projector := projector.New(resolver.New(c))
+				projector := projector.NewWithTypeProjections(resolver.New(c), typeProjections)
+				bindings := make([]*servicebindingv1beta1.ServiceBinding, len(activeServiceBindings))
+				names := make([]string, len(activeServiceBindings))
 				for i := range activeServiceBindings {
 					sb := activeServiceBindings[i].DeepCopy()
 					sb.Default()
-					if err := projector.Project(ctx, sb, workload); err != nil {
+					bindings[i] = sb
+					names[i] = sb.Name
+				}
+				original := workload.DeepCopy()
+				if err := projector.ProjectAll(ctx, bindings, workload); err != nil {
+					return err
+				}
+
+				if req := reconcilers.RetrieveAdmissionRequest(ctx); req.DryRun != nil && *req.DryRun && len(bindings) != 0 {
+					// describe the combined projection to the user as warnings
+					plan, err := projector.PlanChanges(ctx, original, workload)
+					if err != nil {
 						return err
 					}
+					prefix := fmt.Sprintf("ServiceBinding %s", names[0])
+					if len(names) > 1 {
+						prefix = fmt.Sprintf("ServiceBindings %s", strings.Join(names, ", "))
+					}
+					resp := reconcilers.RetrieveAdmissionResponse(ctx)
+					for _, line := range plan.Lines() {
+						resp.Warnings = append(resp.Warnings, fmt.Sprintf("%s: %s", prefix, line))
+					}
 				}
 
 				return nil
@@ -216,10 +252,143 @@ func TriggerReconciler(c reconcilers.Config, name string, accessChecker rbac.Acc
 			return resource.Webhooks[0].Rules
 		},
 
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			watchPermissions(bldr, req, accessChecker)
+			watchCustomResourceDefinitions(bldr, req, mgr.GetRESTMapper())
+			return nil
+		},
 		Config: c,
 	}
 }
 
+// watchPermissions invalidates the access checks and enqueues the request when the RBAC resources change, so that the
+// webhook rules reflect the permissions granted to the controller without waiting for the cached checks to expire.
+// Cluster scoped resources may grant access in any namespace, while Roles and RoleBindings only affect the access
+// checks for their own namespace.
+func watchPermissions(bldr *builder.Builder, req reconcile.Request, accessChecker rbac.AccessChecker) {
+	enqueueCluster := handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		accessChecker.Invalidate()
+		return []reconcile.Request{req}
+	})
+	enqueueNamespace := handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		accessChecker.InvalidateNamespace(o.GetNamespace())
+		return []reconcile.Request{req}
+	})
+	bldr.Watches(&source.Kind{Type: &rbacv1.ClusterRole{}}, enqueueCluster)
+	bldr.Watches(&source.Kind{Type: &rbacv1.ClusterRoleBinding{}}, enqueueCluster)
+	bldr.Watches(&source.Kind{Type: &rbacv1.Role{}}, enqueueNamespace)
+	bldr.Watches(&source.Kind{Type: &rbacv1.RoleBinding{}}, enqueueNamespace)
+}
+
+// mapperResetInterval is the minimum time between resets of the mapper by a CustomResourceDefinition watch. Resetting
+// the mapper drops the discovery cache shared by every controller in the manager.
+const mapperResetInterval = 10 * time.Second
+
+// watchCustomResourceDefinitions resets the mapper and enqueues the request when a CustomResourceDefinition is created,
+// deleted or changes the versions it serves, so that the webhook rules are rebuilt as kinds referenced by
+// ServiceBindings are installed and uninstalled. Resets are rate limited, changes within mapperResetInterval of the
+// last reset are coalesced into a single reset that enqueues the request once it's applied.
+func watchCustomResourceDefinitions(bldr *builder.Builder, req reconcile.Request, mapper meta.RESTMapper) {
+	resettable, ok := mapper.(meta.ResettableRESTMapper)
+	if !ok {
+		// nothing to reset, the rules are rebuilt on the next change to a ServiceBinding
+		return
+	}
+	resetter := &mapperResetter{mapper: resettable, interval: mapperResetInterval}
+	enqueue := func(q workqueue.RateLimitingInterface) {
+		resetter.Reset(func() { q.Add(req) })
+	}
+	bldr.Watches(
+		&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}},
+		handler.Funcs{
+			CreateFunc: func(e event.CreateEvent, q workqueue.RateLimitingInterface) { enqueue(q) },
+			UpdateFunc: func(e event.UpdateEvent, q workqueue.RateLimitingInterface) { enqueue(q) },
+			DeleteFunc: func(e event.DeleteEvent, q workqueue.RateLimitingInterface) { enqueue(q) },
+		},
+		builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return !servedVersions(e.ObjectOld).Equal(servedVersions(e.ObjectNew))
+			},
+			GenericFunc: func(e event.GenericEvent) bool {
+				return false
+			},
+		}),
+	)
+}
+
+// servedVersions returns the versions of an established CustomResourceDefinition that are served by the api server.
+// Discovery doesn't include the kind until the CustomResourceDefinition is established.
+func servedVersions(o client.Object) sets.String {
+	versions := sets.NewString()
+	crd, ok := o.(*apiextensionsv1.CustomResourceDefinition)
+	if !ok {
+		return versions
+	}
+	established := false
+	for _, c := range crd.Status.Conditions {
+		if c.Type == apiextensionsv1.Established && c.Status == apiextensionsv1.ConditionTrue {
+			established = true
+		}
+	}
+	if !established {
+		return versions
+	}
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			versions.Insert(v.Name)
+		}
+	}
+	return versions
+}
+
+// mapperResetter resets a mapper at most once per interval. Requests to reset within the interval of the last reset
+// are deferred until the interval elapses and applied together.
+type mapperResetter struct {
+	mapper   meta.ResettableRESTMapper
+	interval time.Duration
+
+	m       sync.Mutex
+	last    time.Time
+	pending []func()
+}
+
+// Reset resets the mapper and calls done, either immediately or once the deferred reset is applied.
+func (r *mapperResetter) Reset(done func()) {
+	r.m.Lock()
+	if len(r.pending) != 0 {
+		// a reset is already scheduled
+		r.pending = append(r.pending, done)
+		r.m.Unlock()
+		return
+	}
+	if wait := r.interval - time.Since(r.last); wait > 0 {
+		r.pending = append(r.pending, done)
+		r.m.Unlock()
+		time.AfterFunc(wait, r.flush)
+		return
+	}
+	r.reset()
+	r.m.Unlock()
+	done()
+}
+
+func (r *mapperResetter) flush() {
+	r.m.Lock()
+	r.reset()
+	pending := r.pending
+	r.pending = nil
+	r.m.Unlock()
+	for _, done := range pending {
+		done()
+	}
+}
+
+// reset must be called while holding the lock
+func (r *mapperResetter) reset() {
+	r.mapper.Reset()
+	r.last = time.Now()
+}
+
 func TriggerWebhook(c reconcilers.Config, serviceBindingController controller.Controller) *reconcilers.AdmissionWebhookAdapter {
 	return &reconcilers.AdmissionWebhookAdapter{
 		Name: "AdmissionProjectorWebhook",
@@ -294,14 +463,17 @@ func InterceptGVKs() reconcilers.SubReconciler {
 		Sync: func(ctx context.Context, _ client.Object) error {
 			serviceBindings := RetrieveServiceBindings(ctx)
 			gvks := RetrieveObservedGKVs(ctx)
+			namespaces := RetrieveObservedNamespaces(ctx)
 
 			for i := range serviceBindings {
 				workload := serviceBindings[i].Spec.Workload
 				gvk := schema.FromAPIVersionAndKind(workload.APIVersion, workload.Kind)
 				gvks = append(gvks, gvk)
+				observeNamespace(namespaces, gvk.GroupKind(), serviceBindings[i].Namespace)
 			}
 
 			StashObservedGVKs(ctx, gvks)
+			StashObservedNamespaces(ctx, namespaces)
 
 			return nil
 		},
@@ -314,18 +486,30 @@ func TriggerGVKs() reconcilers.SubReconciler {
 		Sync: func(ctx context.Context, _ client.Object) error {
 			serviceBindings := RetrieveServiceBindings(ctx)
 			gvks := RetrieveObservedGKVs(ctx)
+			namespaces := RetrieveObservedNamespaces(ctx)
 
 			for i := range serviceBindings {
-				service := serviceBindings[i].Spec.Service
-				gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
-				if gvk.Kind == "Secret" && (gvk.Group == "" || gvk.Group == "core") {
-					// ignore direct bindings
-					continue
+				services := append([]servicebindingv1beta1.ServiceBindingServiceReference{serviceBindings[i].Spec.Service}, serviceBindings[i].Spec.FallbackServices...)
+				for _, additional := range serviceBindings[i].Spec.AdditionalServices {
+					services = append(services, additional.ServiceBindingServiceReference)
+				}
+				for _, service := range services {
+					gvk := schema.FromAPIVersionAndKind(service.APIVersion, service.Kind)
+					if (gvk.Kind == "Secret" || gvk.Kind == "ConfigMap") && (gvk.Group == "" || gvk.Group == "core") {
+						// ignore direct bindings
+						continue
+					}
+					gvks = append(gvks, gvk)
+					namespace := service.Namespace
+					if namespace == "" {
+						namespace = serviceBindings[i].Namespace
+					}
+					observeNamespace(namespaces, gvk.GroupKind(), namespace)
 				}
-				gvks = append(gvks, gvk)
 			}
 
 			StashObservedGVKs(ctx, gvks)
+			StashObservedNamespaces(ctx, namespaces)
 
 			return nil
 		},
@@ -335,23 +519,41 @@ func TriggerGVKs() reconcilers.SubReconciler {
 func WebhookRules(operations []admissionregistrationv1.OperationType, accessChecker rbac.AccessChecker) reconcilers.SubReconciler {
 	return &reconcilers.SyncReconciler{
 		Name: "WebhookRules",
-		Sync: func(ctx context.Context, _ client.Object) error {
+		Sync: func(ctx context.Context, resource client.Object) error {
 			log := logr.FromContextOrDiscard(ctx)
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 
 			// dedup gvks as gvrs
 			gvks := RetrieveObservedGKVs(ctx)
+			observedNamespaces := RetrieveObservedNamespaces(ctx)
 			groupResources := map[string]map[string]interface{}{}
+			namespaces := map[schema.GroupResource]sets.String{}
+			unknown := map[schema.GroupKind]bool{}
 			for _, gvk := range gvks {
 				rm, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
 				if err != nil {
-					return err
+					if !meta.IsNoMatchError(err) {
+						return err
+					}
+					// the kind is not installed yet, or was uninstalled. The rules are rebuilt when a
+					// CustomResourceDefinition is created, deleted or changes its served versions, skip the kind
+					// rather than blocking every other binding. The ServiceBindings referencing the kind report it on
+					// their own status.
+					if !unknown[gvk.GroupKind()] {
+						unknown[gvk.GroupKind()] = true
+						log.Info("ignoring resource, kind is not known to the cluster", "kind", gvk.GroupKind())
+					}
+					continue
 				}
 				gvr := rm.Resource
 				if _, ok := groupResources[gvr.Group]; !ok {
 					groupResources[gvr.Group] = map[string]interface{}{}
 				}
 				groupResources[gvr.Group][gvr.Resource] = true
+				if _, ok := namespaces[gvr.GroupResource()]; !ok {
+					namespaces[gvr.GroupResource()] = sets.NewString()
+				}
+				namespaces[gvr.GroupResource()].Insert(observedNamespaces[gvk.GroupKind()].UnsortedList()...)
 			}
 
 			// normalize rules to a canonical form
@@ -368,7 +570,7 @@ func WebhookRules(operations []admissionregistrationv1.OperationType, accessChec
 
 				// check that we have permission to interact with these resources. Admission webhooks bypass RBAC
 				for _, resource := range resources.List() {
-					if !accessChecker.CanI(ctx, group, resource) {
+					if !accessChecker.CanI(ctx, group, resource) && !canIInNamespaces(ctx, accessChecker, namespaces[schema.GroupResource{Group: group, Resource: resource}], group, resource) {
 						log.Info("ignoring resource, access denied", "group", group, "resource", resource)
 						resources.Delete(resource)
 					}
@@ -395,6 +597,21 @@ func WebhookRules(operations []admissionregistrationv1.OperationType, accessChec
 	}
 }
 
+// canIInNamespaces checks that the controller is permitted to use the resource in each of the namespaces. Permission
+// in every namespace with a ServiceBinding for the resource is sufficient when the controller is not permitted to use
+// the resource in all namespaces.
+func canIInNamespaces(ctx context.Context, accessChecker rbac.AccessChecker, namespaces sets.String, group, resource string) bool {
+	if namespaces.Len() == 0 {
+		return false
+	}
+	for _, namespace := range namespaces.List() {
+		if !accessChecker.CanIInNamespace(ctx, namespace, group, resource) {
+			return false
+		}
+	}
+	return true
+}
+
 const ServiceBindingsStashKey reconcilers.StashKey = "servicebinding.io:servicebindings"
 
 func StashServiceBindings(ctx context.Context, serviceBindings []servicebindingv1beta1.ServiceBinding) {
@@ -423,6 +640,28 @@ func RetrieveObservedGKVs(ctx context.Context) []schema.GroupVersionKind {
 	return nil
 }
 
+const ObservedNamespacesStashKey reconcilers.StashKey = "servicebinding.io:observednamespaces"
+
+func StashObservedNamespaces(ctx context.Context, namespaces map[schema.GroupKind]sets.String) {
+	reconcilers.StashValue(ctx, ObservedNamespacesStashKey, namespaces)
+}
+
+func RetrieveObservedNamespaces(ctx context.Context) map[schema.GroupKind]sets.String {
+	value := reconcilers.RetrieveValue(ctx, ObservedNamespacesStashKey)
+	if namespaces, ok := value.(map[schema.GroupKind]sets.String); ok {
+		return namespaces
+	}
+	return map[schema.GroupKind]sets.String{}
+}
+
+// observeNamespace records the namespace of a ServiceBinding that references the kind
+func observeNamespace(namespaces map[schema.GroupKind]sets.String, gk schema.GroupKind, namespace string) {
+	if _, ok := namespaces[gk]; !ok {
+		namespaces[gk] = sets.NewString()
+	}
+	namespaces[gk].Insert(namespace)
+}
+
 const WebhookRulesStashKey reconcilers.StashKey = "servicebinding.io:webhookrules"
 
 func StashWebhookRules(ctx context.Context, rules []admissionregistrationv1.RuleWithOperations) {
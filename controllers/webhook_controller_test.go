@@ -41,8 +41,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -54,6 +56,7 @@ import (
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 	"github.com/scothis/servicebinding-runtime/controllers"
 	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+	"github.com/scothis/servicebinding-runtime/projector"
 	"github.com/scothis/servicebinding-runtime/rbac"
 )
 
@@ -372,6 +375,83 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 				},
 			},
 		},
+		"binding projected on dry run": {
+			GivenObjects: []client.Object{
+				serviceBinding.SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+					d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+						d.APIVersion("apps/v1")
+						d.Kind("Deployment")
+						d.Name(name)
+					})
+				}),
+			},
+			Request: &admission.Request{
+				AdmissionRequest: request.
+					Object(workload.DieReleaseRawExtension()).
+					DryRun(pointer.Bool(true)).
+					DieRelease(),
+			},
+			ExpectedResponse: admission.Response{
+				AdmissionResponse: response.
+					DieStamp(func(r *admissionv1.AdmissionResponse) {
+						r.Warnings = []string{
+							fmt.Sprintf(`ServiceBinding my-workload: + annotation projector.servicebinding.io/secret-%s="my-binding"`, bindingUID),
+							fmt.Sprintf("ServiceBinding my-workload: + volume servicebinding-%s", bindingUID),
+							"ServiceBinding my-workload: + container workload env SERVICE_BINDING_ROOT",
+							fmt.Sprintf("ServiceBinding my-workload: + container workload volumeMount servicebinding-%s at /bindings/my-workload", bindingUID),
+						}
+					}).
+					DieRelease(),
+				Patches: []jsonpatch.Operation{
+					{
+						Operation: "add",
+						Path:      "/spec/template/metadata/annotations",
+						Value: map[string]interface{}{
+							fmt.Sprintf("projector.servicebinding.io/secret-%s", bindingUID): secret,
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/env",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":  "SERVICE_BINDING_ROOT",
+								"value": "/bindings",
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/containers/0/volumeMounts",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name":      fmt.Sprintf("servicebinding-%s", bindingUID),
+								"mountPath": "/bindings/my-workload",
+								"readOnly":  true,
+							},
+						},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/template/spec/volumes",
+						Value: []interface{}{
+							map[string]interface{}{
+								"name": fmt.Sprintf("servicebinding-%s", bindingUID),
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"secret": map[string]interface{}{
+												"name": secret,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"binding projected by selector": {
 			GivenObjects: []client.Object{
 				serviceBinding.SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
@@ -486,7 +566,7 @@ func TestAdmissionProjectorWebhook(t *testing.T) {
 	wts.Run(t, scheme, func(t *testing.T, wtc *rtesting.AdmissionWebhookTestCase, c reconcilers.Config) *admission.Webhook {
 		restMapper := c.RESTMapper().(*meta.DefaultRESTMapper)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
-		return controllers.AdmissionProjectorWebhook(c).Build()
+		return controllers.AdmissionProjectorWebhook(c, projector.DefaultTypeProjections()).Build()
 	})
 }
 
@@ -830,6 +910,9 @@ func TestInterceptGVKs(t *testing.T) {
 				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
 					{Group: "apps", Version: "v1", Kind: "Deployment"},
 				},
+				controllers.ObservedNamespacesStashKey: map[schema.GroupKind]sets.String{
+					{Group: "apps", Kind: "Deployment"}: sets.NewString("my-namespace"),
+				},
 			},
 		},
 		"append workload gvks": {
@@ -893,6 +976,9 @@ func TestTriggerGVKs(t *testing.T) {
 				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
 					{Group: "example", Version: "v1", Kind: "MyService"},
 				},
+				controllers.ObservedNamespacesStashKey: map[schema.GroupKind]sets.String{
+					{Group: "example", Kind: "MyService"}: sets.NewString("my-namespace"),
+				},
 			},
 		},
 		"append service gvks": {
@@ -912,6 +998,29 @@ func TestTriggerGVKs(t *testing.T) {
 				},
 			},
 		},
+		"collect fallback service gvks": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+					serviceBinding.
+						SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+							d.FallbackServicesDie(
+								dieservicebindingv1beta1.ServiceBindingServiceReferenceBlank.
+									APIVersion("example/v1").
+									Kind("MyOtherService").
+									Name("my-other-service"),
+							)
+						}).
+						DieRelease(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "example", Version: "v1", Kind: "MyService"},
+					{Group: "example", Version: "v1", Kind: "MyOtherService"},
+				},
+			},
+		},
 		"ignore direct binding": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
@@ -930,6 +1039,24 @@ func TestTriggerGVKs(t *testing.T) {
 				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{},
 			},
 		},
+		"ignore direct config map binding": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ServiceBindingsStashKey: []servicebindingv1beta1.ServiceBinding{
+					serviceBinding.
+						SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+							d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+								d.APIVersion("v1")
+								d.Kind("ConfigMap")
+							})
+						}).
+						DieRelease(),
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{},
+			},
+		},
 	}
 
 	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
@@ -1077,14 +1204,75 @@ func TestWebhookRules(t *testing.T) {
 				selfSubjectAccessReviewFor("batch", "jobs", "get"),
 			},
 		},
-		"error on unknown resource": {
+		"skip unknown resource": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
 					{Group: "foo", Version: "v1", Kind: "Bar"},
+					{Group: "foo", Version: "v1beta1", Kind: "Bar"},
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
 				},
 			},
-			ShouldErr: true,
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("apps", "deployments", "get"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: operations,
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"*"},
+							Resources:   []string{"deployments"},
+						},
+					},
+				},
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+			},
+		},
+		"add resource when the kind is installed while bindings exist": {
+			Metadata: map[string]interface{}{
+				"InstalledKinds": []schema.GroupVersionKind{
+					{Group: "example.com", Version: "v1", Kind: "Widget"},
+				},
+			},
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "example.com", Version: "v1", Kind: "Widget"},
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewFor("example.com", "widgets", "get"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: operations,
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"example.com"},
+							APIVersions: []string{"*"},
+							Resources:   []string{"widgets"},
+						},
+					},
+				},
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("example.com", "widgets", "get"),
+			},
+		},
+		"remove resource when the kind is uninstalled while bindings exist": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "example.com", Version: "v1", Kind: "Widget"},
+				},
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{},
+			},
 		},
 		"drop denied resources": {
 			Resource: webhook,
@@ -1100,6 +1288,60 @@ func TestWebhookRules(t *testing.T) {
 				selfSubjectAccessReviewFor("apps", "deployments", "get"),
 			},
 		},
+		"allow resources permitted in each observed namespace": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+				controllers.ObservedNamespacesStashKey: map[schema.GroupKind]sets.String{
+					{Group: "apps", Kind: "Deployment"}: sets.NewString("ns-a", "ns-b"),
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewInNamespaceFor("ns-a", "apps", "deployments", "get"),
+				allowSelfSubjectAccessReviewInNamespaceFor("ns-b", "apps", "deployments", "get"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: operations,
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"*"},
+							Resources:   []string{"deployments"},
+						},
+					},
+				},
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewInNamespaceFor("ns-a", "apps", "deployments", "get"),
+				selfSubjectAccessReviewInNamespaceFor("ns-b", "apps", "deployments", "get"),
+			},
+		},
+		"drop resources denied in an observed namespace": {
+			Resource: webhook,
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ObservedGVKsStashKey: []schema.GroupVersionKind{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+				},
+				controllers.ObservedNamespacesStashKey: map[schema.GroupKind]sets.String{
+					{Group: "apps", Kind: "Deployment"}: sets.NewString("ns-a", "ns-b"),
+				},
+			},
+			WithReactors: []rtesting.ReactionFunc{
+				allowSelfSubjectAccessReviewInNamespaceFor("ns-a", "apps", "deployments", "get"),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.WebhookRulesStashKey: []admissionregistrationv1.RuleWithOperations{},
+			},
+			ExpectCreates: []client.Object{
+				selfSubjectAccessReviewFor("apps", "deployments", "get"),
+				selfSubjectAccessReviewInNamespaceFor("ns-a", "apps", "deployments", "get"),
+				selfSubjectAccessReviewInNamespaceFor("ns-b", "apps", "deployments", "get"),
+			},
+		},
 		"treat SelfSubjectAccessReview errors as denied": {
 			Resource: webhook,
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
@@ -1125,6 +1367,11 @@ func TestWebhookRules(t *testing.T) {
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1beta1", Kind: "Deployment"}, meta.RESTScopeNamespace)
 		restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, meta.RESTScopeNamespace)
 		restMapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, meta.RESTScopeNamespace)
+		if kinds, ok := rtc.Metadata["InstalledKinds"].([]schema.GroupVersionKind); ok {
+			for _, gvk := range kinds {
+				restMapper.Add(gvk, meta.RESTScopeNamespace)
+			}
+		}
 		accessChecker := rbac.NewAccessChecker(c, 0).WithVerb("get")
 		return controllers.WebhookRules(operations, accessChecker)
 	})
@@ -1142,6 +1389,30 @@ func selfSubjectAccessReviewFor(group, resource, verb string) *authorizationv1.S
 	}
 }
 
+func selfSubjectAccessReviewInNamespaceFor(namespace, group, resource, verb string) *authorizationv1.SelfSubjectAccessReview {
+	ssar := selfSubjectAccessReviewFor(group, resource, verb)
+	ssar.Spec.ResourceAttributes.Namespace = namespace
+	return ssar
+}
+
+func allowSelfSubjectAccessReviewInNamespaceFor(namespace, group, resource, verb string) rtesting.ReactionFunc {
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		r := action.GetResource()
+		if r.Group != "authorization.k8s.io" || r.Resource != "SelfSubjectAccessReview" || r.Version != "v1" || action.GetVerb() != "create" {
+			// ignore, not creating a SelfSubjectAccessReview
+			return false, nil, nil
+		}
+		ssar := action.(rtesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		if ra := ssar.Spec.ResourceAttributes; ra != nil {
+			if ra.Namespace == namespace && ra.Group == group && ra.Resource == resource && ra.Verb == verb {
+				ssar.Status.Allowed = true
+				return true, ssar, nil
+			}
+		}
+		return false, nil, nil
+	}
+}
+
 func allowSelfSubjectAccessReviewFor(group, resource, verb string) rtesting.ReactionFunc {
 	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
 		r := action.GetResource()
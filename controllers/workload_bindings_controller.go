@@ -0,0 +1,109 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+//+kubebuilder:rbac:groups=servicebinding.io,resources=servicebindings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// WorkloadBindingsReconciler reconciles the bindings declared by the servicebinding.io/bindings annotation of workloads
+// of the kind. A ServiceBinding, owned by the workload, is generated for each declared binding. Access to the workload
+// resource is granted to the controller by the same aggregated role as for the ServiceBinding reconciler.
+func WorkloadBindingsReconciler(c reconcilers.Config, gvk schema.GroupVersionKind) *reconcilers.ResourceReconciler {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(gvk)
+
+	return &reconcilers.ResourceReconciler{
+		Name:       fmt.Sprintf("%sWorkloadBindingsReconciler", gvk.Kind),
+		Type:       workload,
+		Reconciler: ManageWorkloadServiceBindings(),
+
+		Config: c,
+	}
+}
+
+func ManageWorkloadServiceBindings() reconcilers.SubReconciler {
+	manager := newServiceBindingManager("WorkloadServiceBindingManager")
+
+	return &reconcilers.SyncReconciler{
+		Name: "ManageWorkloadServiceBindings",
+		Sync: func(ctx context.Context, resource client.Object) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			bindings, err := servicebindingv1beta1.ParseWorkloadBindings(resource.GetAnnotations())
+			if err != nil {
+				// leave the ServiceBindings for the last valid bindings in place until the annotation is fixed
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "InvalidBindings", "Invalid bindings: %v", err)
+				return nil
+			}
+
+			_, err = manageControlledServiceBindings(ctx, manager, resource, desiredWorkloadServiceBindings(resource, bindings))
+			return err
+		},
+		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
+			bldr.Owns(&servicebindingv1beta1.ServiceBinding{})
+			return manager.Setup(ctx)
+		},
+	}
+}
+
+func desiredWorkloadServiceBindings(workload client.Object, bindings []servicebindingv1beta1.BindingPolicyBinding) []*servicebindingv1beta1.ServiceBinding {
+	gvk := workload.GetObjectKind().GroupVersionKind()
+	serviceBindings := make([]*servicebindingv1beta1.ServiceBinding, len(bindings))
+	for i := range bindings {
+		binding := bindings[i].DeepCopy()
+		serviceBindings[i] = &servicebindingv1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: workload.GetNamespace(),
+				// the kind avoids conflicts between workloads of different kinds with the same name
+				Name: fmt.Sprintf("%s-%s-%s", workload.GetName(), strings.ToLower(gvk.Kind), binding.Name),
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(workload, gvk),
+				},
+			},
+			Spec: servicebindingv1beta1.ServiceBindingSpec{
+				Name:     binding.Name,
+				Type:     binding.Type,
+				Provider: binding.Provider,
+				Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+					APIVersion: gvk.GroupVersion().String(),
+					Kind:       gvk.Kind,
+					Name:       workload.GetName(),
+				},
+				Service: binding.Service,
+				Env:     binding.Env,
+			},
+		}
+	}
+	return serviceBindings
+}
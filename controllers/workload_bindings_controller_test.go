@@ -0,0 +1,142 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	dieappsv1 "dies.dev/apis/apps/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/controllers"
+	dieservicebindingv1beta1 "github.com/scothis/servicebinding-runtime/dies/v1beta1"
+)
+
+func TestManageWorkloadServiceBindings(t *testing.T) {
+	namespace := "test-namespace"
+	name := "my-workload"
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	workload := dieappsv1.DeploymentBlank.
+		APIVersion("apps/v1").
+		Kind("Deployment").
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name(name)
+			d.UID("11111111-1111-1111-1111-111111111111")
+		})
+	annotatedWorkload := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddAnnotation(servicebindingv1beta1.WorkloadBindingsAnnotation, `[{"service": {"apiVersion": "v1", "kind": "Secret", "name": "orders-db"}}]`)
+		})
+
+	serviceBinding := dieservicebindingv1beta1.ServiceBindingBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("my-workload-deployment-orders-db")
+			d.ControlledBy(workload, scheme)
+		}).
+		SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+			d.Name("orders-db")
+			d.WorkloadDie(func(d *dieservicebindingv1beta1.ServiceBindingWorkloadReferenceDie) {
+				d.APIVersion("apps/v1")
+				d.Kind("Deployment")
+				d.Name(name)
+			})
+			d.ServiceDie(func(d *dieservicebindingv1beta1.ServiceBindingServiceReferenceDie) {
+				d.APIVersion("v1")
+				d.Kind("Secret")
+				d.Name("orders-db")
+			})
+		})
+
+	rts := rtesting.SubReconcilerTests{
+		"workload without bindings": {
+			Resource: workload,
+		},
+		"create service binding": {
+			Resource: annotatedWorkload,
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Created", "Created ServiceBinding %q", "my-workload-deployment-orders-db"),
+			},
+			ExpectCreates: []client.Object{
+				serviceBinding,
+			},
+		},
+		"service binding in sync": {
+			Resource: annotatedWorkload,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+		},
+		"update service binding": {
+			Resource: annotatedWorkload,
+			GivenObjects: []client.Object{
+				serviceBinding.
+					SpecDie(func(d *dieservicebindingv1beta1.ServiceBindingSpecDie) {
+						d.Type("postgresql")
+					}),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Updated", "Updated ServiceBinding %q", "my-workload-deployment-orders-db"),
+			},
+			ExpectUpdates: []client.Object{
+				serviceBinding,
+			},
+		},
+		"delete service binding when the annotation is removed": {
+			Resource: workload,
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBinding %q", "my-workload-deployment-orders-db"),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{
+				rtesting.NewDeleteRefFromObject(serviceBinding, scheme),
+			},
+		},
+		"malformed annotation": {
+			Resource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(servicebindingv1beta1.WorkloadBindingsAnnotation, "orders-db")
+				}),
+			GivenObjects: []client.Object{
+				serviceBinding,
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "InvalidBindings", "Invalid bindings: %s",
+					`metadata.annotations[servicebinding.io/bindings]: Invalid value: "orders-db": must be a JSON list of bindings: invalid character 'o' looking for beginning of value`),
+			},
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		return controllers.ManageWorkloadServiceBindings()
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	diemetav1 "dies.dev/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.BindingPolicy
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicySpec
+
+func (d *BindingPolicySpecDie) WorkloadsDie(workloads ...*BindingPolicyWorkloadSelectorDie) *BindingPolicySpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicySpec) {
+		r.Workloads = make([]servicebindingv1beta1.BindingPolicyWorkloadSelector, len(workloads))
+		for i := range workloads {
+			r.Workloads[i] = workloads[i].DieRelease()
+		}
+	})
+}
+
+func (d *BindingPolicySpecDie) BindingsDie(bindings ...*BindingPolicyBindingDie) *BindingPolicySpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicySpec) {
+		r.Bindings = make([]servicebindingv1beta1.BindingPolicyBinding, len(bindings))
+		for i := range bindings {
+			r.Bindings[i] = bindings[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicyWorkloadSelector
+
+func (d *BindingPolicyWorkloadSelectorDie) SelectorDie(fn func(d *diemetav1.LabelSelectorDie)) *BindingPolicyWorkloadSelectorDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicyWorkloadSelector) {
+		d := diemetav1.LabelSelectorBlank.DieImmutable(false).DieFeed(r.Selector)
+		fn(d)
+		r.Selector = d.DieRelease()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicyBinding
+
+func (d *BindingPolicyBindingDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicyBinding) {
+		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeed(r.Service)
+		fn(d)
+		r.Service = d.DieRelease()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicyStatus
+
+func (d *BindingPolicyStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionDie) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicyStatus) {
+		r.Conditions = make([]metav1.Condition, len(conditions))
+		for i := range conditions {
+			r.Conditions[i] = conditions[i].DieRelease()
+		}
+	})
+}
+
+var BindingPolicyConditionReady = diemetav1.ConditionBlank.Type(servicebindingv1beta1.BindingPolicyConditionReady).Unknown().Reason("Initializing")
+var BindingPolicyConditionServiceBindingsReady = diemetav1.ConditionBlank.Type(servicebindingv1beta1.BindingPolicyConditionServiceBindingsReady).Unknown().Reason("Initializing")
+
+func (d *BindingPolicyStatusDie) ServiceBindingsDie(serviceBindings ...*BindingPolicyServiceBindingStatusDie) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicyStatus) {
+		r.ServiceBindings = make([]servicebindingv1beta1.BindingPolicyServiceBindingStatus, len(serviceBindings))
+		for i := range serviceBindings {
+			r.ServiceBindings[i] = serviceBindings[i].DieRelease()
+		}
+	})
+}
+
+func (d *BindingPolicyStatusDie) WorkloadsDie(workloads ...*BindingPolicyWorkloadStatusDie) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.BindingPolicyStatus) {
+		r.Workloads = make([]servicebindingv1beta1.BindingPolicyWorkloadStatus, len(workloads))
+		for i := range workloads {
+			r.Workloads[i] = workloads[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicyServiceBindingStatus
+
+// +die
+type _ = servicebindingv1beta1.BindingPolicyWorkloadStatus
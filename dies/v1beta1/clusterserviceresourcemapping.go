@@ -0,0 +1,59 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ClusterServiceResourceMapping
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingSpec
+
+func (d *ClusterServiceResourceMappingSpecDie) VersionsDie(version string, fn func(d *ClusterServiceResourceMappingTemplateDie)) *ClusterServiceResourceMappingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingSpec) {
+		for i := range r.Versions {
+			if version == r.Versions[i].Version {
+				d := ClusterServiceResourceMappingTemplateBlank.DieImmutable(false).DieFeed(r.Versions[i])
+				fn(d)
+				r.Versions[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ClusterServiceResourceMappingTemplateBlank.DieImmutable(false).DieFeed(servicebindingv1beta1.ClusterServiceResourceMappingTemplate{Version: version})
+		fn(d)
+		r.Versions = append(r.Versions, d.DieRelease())
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingTemplate
+
+func (d *ClusterServiceResourceMappingTemplateDie) DataDie(data ...*ClusterServiceResourceMappingDataDie) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.Data = make([]servicebindingv1beta1.ClusterServiceResourceMappingData, len(data))
+		for i := range data {
+			r.Data[i] = data[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterServiceResourceMappingData
@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	diemetav1 "dies.dev/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 )
 
@@ -55,5 +58,55 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) ContainersDie(containers ...
 	})
 }
 
+func (d *ClusterWorkloadResourceMappingTemplateDie) PodTemplatesDie(podTemplates ...*ClusterWorkloadResourceMappingPodTemplateDie) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.PodTemplates = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate, len(podTemplates))
+		for i := range podTemplates {
+			r.PodTemplates[i] = podTemplates[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate
+
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) ContainersDie(containers ...*ClusterWorkloadResourceMappingContainerDie) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Containers = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingContainer, len(containers))
+		for i := range containers {
+			r.Containers[i] = containers[i].DieRelease()
+		}
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingContainer
+
+// +die
+type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingStatus
+
+func (d *ClusterWorkloadResourceMappingStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionDie) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.Conditions = make([]metav1.Condition, len(conditions))
+		for i := range conditions {
+			r.Conditions[i] = conditions[i].DieRelease()
+		}
+	})
+}
+
+var ClusterWorkloadResourceMappingConditionReady = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionReady).Unknown().Reason("Initializing")
+var ClusterWorkloadResourceMappingConditionResourceResolved = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionResourceResolved).Unknown().Reason("Initializing")
+var ClusterWorkloadResourceMappingConditionVersionsServed = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionVersionsServed).Unknown().Reason("Initializing")
+var ClusterWorkloadResourceMappingConditionPathsResolved = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ClusterWorkloadResourceMappingConditionPathsResolved).Unknown().Reason("Initializing")
+
+func (d *ClusterWorkloadResourceMappingStatusDie) VersionsDie(versions ...*ClusterWorkloadResourceMappingVersionStatusDie) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.Versions = make([]servicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatus, len(versions))
+		for i := range versions {
+			r.Versions[i] = versions[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ClusterWorkloadResourceMappingVersionStatus
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ReferenceGrant
+
+// +die
+type _ = servicebindingv1beta1.ReferenceGrantSpec
+
+func (d *ReferenceGrantSpecDie) FromDie(from ...*ReferenceGrantFromDie) *ReferenceGrantSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ReferenceGrantSpec) {
+		r.From = make([]servicebindingv1beta1.ReferenceGrantFrom, len(from))
+		for i := range from {
+			r.From[i] = from[i].DieRelease()
+		}
+	})
+}
+
+func (d *ReferenceGrantSpecDie) ToDie(to ...*ReferenceGrantToDie) *ReferenceGrantSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ReferenceGrantSpec) {
+		r.To = make([]servicebindingv1beta1.ReferenceGrantTo, len(to))
+		for i := range to {
+			r.To[i] = to[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ReferenceGrantFrom
+
+// +die
+type _ = servicebindingv1beta1.ReferenceGrantTo
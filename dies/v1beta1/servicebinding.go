@@ -45,6 +45,24 @@ func (d *ServiceBindingSpecDie) ServiceDie(fn func(d *ServiceBindingServiceRefer
 	})
 }
 
+func (d *ServiceBindingSpecDie) FallbackServicesDie(services ...*ServiceBindingServiceReferenceDie) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		r.FallbackServices = make([]servicebindingv1beta1.ServiceBindingServiceReference, len(services))
+		for i := range services {
+			r.FallbackServices[i] = services[i].DieRelease()
+		}
+	})
+}
+
+func (d *ServiceBindingSpecDie) AdditionalServicesDie(services ...*ServiceBindingAdditionalServiceReferenceDie) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
+		r.AdditionalServices = make([]servicebindingv1beta1.ServiceBindingAdditionalServiceReference, len(services))
+		for i := range services {
+			r.AdditionalServices[i] = services[i].DieRelease()
+		}
+	})
+}
+
 func (d *ServiceBindingSpecDie) EnvDie(key string, fn func(d *EnvMappingDie)) *ServiceBindingSpecDie {
 	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingSpec) {
 		for i := range r.Env {
@@ -76,6 +94,31 @@ func (d *ServiceBindingWorkloadReferenceDie) SelectorDie(fn func(d *diemetav1.La
 // +die
 type _ = servicebindingv1beta1.ServiceBindingServiceReference
 
+func (d *ServiceBindingServiceReferenceDie) SelectorDie(fn func(d *diemetav1.LabelSelectorDie)) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingServiceReference) {
+		d := diemetav1.LabelSelectorBlank.DieImmutable(false).DieFeedPtr(r.Selector)
+		fn(d)
+		r.Selector = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingAdditionalServiceReference
+
+func (d *ServiceBindingAdditionalServiceReferenceDie) Service(v servicebindingv1beta1.ServiceBindingServiceReference) *ServiceBindingAdditionalServiceReferenceDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingAdditionalServiceReference) {
+		r.ServiceBindingServiceReference = v
+	})
+}
+
+func (d *ServiceBindingAdditionalServiceReferenceDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingAdditionalServiceReferenceDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingAdditionalServiceReference) {
+		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeed(r.ServiceBindingServiceReference)
+		fn(d)
+		r.ServiceBindingServiceReference = d.DieRelease()
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.EnvMapping
 
@@ -93,7 +136,9 @@ func (d *ServiceBindingStatusDie) ConditionsDie(conditions ...*diemetav1.Conditi
 
 var ServiceBindingConditionReady = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ServiceBindingConditionReady).Unknown().Reason("Initializing")
 var ServiceBindingConditionServiceAvailable = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ServiceBindingConditionServiceAvailable).Unknown().Reason("Initializing")
+var ServiceBindingConditionBindingValid = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ServiceBindingConditionBindingValid).Unknown().Reason("Initializing")
 var ServiceBindingConditionWorkloadProjected = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ServiceBindingConditionWorkloadProjected).Unknown().Reason("Initializing")
+var ServiceBindingConditionPolicyCompliant = diemetav1.ConditionBlank.Type(servicebindingv1beta1.ServiceBindingConditionPolicyCompliant).Unknown().Reason("Initializing")
 
 func (d *ServiceBindingStatusDie) BindingDie(fn func(d *ServiceBindingSecretReferenceDie)) *ServiceBindingStatusDie {
 	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
@@ -103,5 +148,56 @@ func (d *ServiceBindingStatusDie) BindingDie(fn func(d *ServiceBindingSecretRefe
 	})
 }
 
+func (d *ServiceBindingStatusDie) ServiceDie(fn func(d *ServiceBindingServiceReferenceDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
+		d := ServiceBindingServiceReferenceBlank.DieImmutable(false).DieFeedPtr(r.Service)
+		fn(d)
+		r.Service = d.DieReleasePtr()
+	})
+}
+
+func (d *ServiceBindingStatusDie) ConfigMapDie(fn func(d *ServiceBindingConfigMapReferenceDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
+		d := ServiceBindingConfigMapReferenceBlank.DieImmutable(false).DieFeedPtr(r.ConfigMap)
+		fn(d)
+		r.ConfigMap = d.DieReleasePtr()
+	})
+}
+
+func (d *ServiceBindingStatusDie) ReferenceGrantDie(fn func(d *ServiceBindingReferenceGrantStatusDie)) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
+		d := ServiceBindingReferenceGrantStatusBlank.DieImmutable(false).DieFeedPtr(r.ReferenceGrant)
+		fn(d)
+		r.ReferenceGrant = d.DieReleasePtr()
+	})
+}
+
+func (d *ServiceBindingStatusDie) AdditionalServicesDie(services ...*ServiceBindingAdditionalServiceStatusDie) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingStatus) {
+		r.AdditionalServices = make([]servicebindingv1beta1.ServiceBindingAdditionalServiceStatus, len(services))
+		for i := range services {
+			r.AdditionalServices[i] = services[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingAdditionalServiceStatus
+
+func (d *ServiceBindingAdditionalServiceStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionDie) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Conditions = make([]metav1.Condition, len(conditions))
+		for i := range conditions {
+			r.Conditions[i] = conditions[i].DieRelease()
+		}
+	})
+}
+
 // +die
 type _ = servicebindingv1beta1.ServiceBindingSecretReference
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingConfigMapReference
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingReferenceGrantStatus
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	diemetav1 "dies.dev/apis/meta/v1"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// +die:object=true
+type _ = servicebindingv1beta1.ServiceBindingPolicy
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingPolicySpec
+
+func (d *ServiceBindingPolicySpecDie) NamespaceSelectorDie(fn func(d *diemetav1.LabelSelectorDie)) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingPolicySpec) {
+		d := diemetav1.LabelSelectorBlank.DieImmutable(false).DieFeedPtr(r.NamespaceSelector)
+		fn(d)
+		r.NamespaceSelector = d.DieReleasePtr()
+	})
+}
+
+func (d *ServiceBindingPolicySpecDie) ServicesDie(services ...*ServiceBindingPolicyResourceDie) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingPolicySpec) {
+		r.Services = make([]servicebindingv1beta1.ServiceBindingPolicyResource, len(services))
+		for i := range services {
+			r.Services[i] = services[i].DieRelease()
+		}
+	})
+}
+
+func (d *ServiceBindingPolicySpecDie) WorkloadsDie(workloads ...*ServiceBindingPolicyResourceDie) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *servicebindingv1beta1.ServiceBindingPolicySpec) {
+		r.Workloads = make([]servicebindingv1beta1.ServiceBindingPolicyResource, len(workloads))
+		for i := range workloads {
+			r.Workloads[i] = workloads[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = servicebindingv1beta1.ServiceBindingPolicyResource
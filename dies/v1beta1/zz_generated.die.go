@@ -34,16 +34,16 @@ import (
 	apisv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 )
 
-var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMapping{})
+var BindingPolicyBlank = (&BindingPolicyDie{}).DieFeed(apisv1beta1.BindingPolicy{})
 
-type ClusterWorkloadResourceMappingDie struct {
+type BindingPolicyDie struct {
 	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMapping
+	r       apisv1beta1.BindingPolicy
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DieImmutable(immutable bool) *BindingPolicyDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -53,13 +53,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *Cluste
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DieFeed(r apisv1beta1.BindingPolicy) *BindingPolicyDie {
 	if d.mutable {
 		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingDie{
+	return &BindingPolicyDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -67,23 +67,23 @@ func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloa
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DieFeedPtr(r *apisv1beta1.BindingPolicy) *BindingPolicyDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMapping{}
+		r = &apisv1beta1.BindingPolicy{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMapping{}
+	r := apisv1beta1.BindingPolicy{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMapping {
+func (d *BindingPolicyDie) DieRelease() apisv1beta1.BindingPolicy {
 	if d.mutable {
 		return d.r
 	}
@@ -91,13 +91,13 @@ func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWork
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMapping {
+func (d *BindingPolicyDie) DieReleasePtr() *apisv1beta1.BindingPolicy {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+func (d *BindingPolicyDie) DieReleaseUnstructured() runtime.Unstructured {
 	r := d.DieReleasePtr()
 	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
 	return &unstructured.Unstructured{
@@ -106,7 +106,7 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Uns
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -115,67 +115,67 @@ func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DieStamp(fn func(r *apisv1beta1.BindingPolicy)) *BindingPolicyDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
+func (d *BindingPolicyDie) DeepCopy() *BindingPolicyDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingDie{
+	return &BindingPolicyDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
 	}
 }
 
-var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
+var _ runtime.Object = (*BindingPolicyDie)(nil)
 
-func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+func (d *BindingPolicyDie) DeepCopyObject() runtime.Object {
 	return d.r.DeepCopy()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+func (d *BindingPolicyDie) GetObjectKind() schema.ObjectKind {
 	r := d.DieRelease()
 	return r.GetObjectKind()
 }
 
-func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+func (d *BindingPolicyDie) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.r)
 }
 
-func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
-	if d == ClusterWorkloadResourceMappingBlank {
+func (d *BindingPolicyDie) UnmarshalJSON(b []byte) error {
+	if d == BindingPolicyBlank {
 		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
 	}
 	if !d.mutable {
 		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
 	}
-	r := &apisv1beta1.ClusterWorkloadResourceMapping{}
+	r := &apisv1beta1.BindingPolicy{}
 	err := json.Unmarshal(b, r)
 	*d = *d.DieFeed(*r)
 	return err
 }
 
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *BindingPolicyDie) APIVersion(v string) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
 		r.APIVersion = v
 	})
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *BindingPolicyDie) Kind(v string) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
 		r.Kind = v
 	})
 }
 
 // MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+func (d *BindingPolicyDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
 		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
 		r.ObjectMeta = d.DieRelease()
@@ -183,29 +183,44 @@ func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMeta
 }
 
 // SpecDie stamps the resource's spec field with a mutable die.
-func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
-		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+func (d *BindingPolicyDie) SpecDie(fn func(d *BindingPolicySpecDie)) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
+		d := BindingPolicySpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
 		r.Spec = d.DieRelease()
 	})
 }
 
-func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *BindingPolicyDie) StatusDie(fn func(d *BindingPolicyStatusDie)) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
+		d := BindingPolicyStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *BindingPolicyDie) Spec(v apisv1beta1.BindingPolicySpec) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
 		r.Spec = v
 	})
 }
 
-var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingSpec{})
+func (d *BindingPolicyDie) Status(v apisv1beta1.BindingPolicyStatus) *BindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicy) {
+		r.Status = v
+	})
+}
 
-type ClusterWorkloadResourceMappingSpecDie struct {
+var BindingPolicySpecBlank = (&BindingPolicySpecDie{}).DieFeed(apisv1beta1.BindingPolicySpec{})
+
+type BindingPolicySpecDie struct {
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingSpec
+	r       apisv1beta1.BindingPolicySpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DieImmutable(immutable bool) *BindingPolicySpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -215,35 +230,35 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *Cl
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DieFeed(r apisv1beta1.BindingPolicySpec) *BindingPolicySpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &BindingPolicySpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DieFeedPtr(r *apisv1beta1.BindingPolicySpec) *BindingPolicySpecDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+		r = &apisv1beta1.BindingPolicySpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicySpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	r := apisv1beta1.BindingPolicySpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingSpec {
+func (d *BindingPolicySpecDie) DieRelease() apisv1beta1.BindingPolicySpec {
 	if d.mutable {
 		return d.r
 	}
@@ -251,13 +266,13 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.Cluster
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingSpec {
+func (d *BindingPolicySpecDie) DieReleasePtr() *apisv1beta1.BindingPolicySpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicySpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -266,37 +281,44 @@ func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DieStamp(fn func(r *apisv1beta1.BindingPolicySpec)) *BindingPolicySpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
+func (d *BindingPolicySpecDie) DeepCopy() *BindingPolicySpecDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingSpecDie{
+	return &BindingPolicySpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Versions is the collection of versions for a given resource, with mappings.
-func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) {
-		r.Versions = v
+// Workloads is the collection of workload selectors the bindings are projected into
+func (d *BindingPolicySpecDie) Workloads(v ...apisv1beta1.BindingPolicyWorkloadSelector) *BindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicySpec) {
+		r.Workloads = v
 	})
 }
 
-var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingTemplate{})
+// Bindings is the collection of bindings projected into each selected workload
+func (d *BindingPolicySpecDie) Bindings(v ...apisv1beta1.BindingPolicyBinding) *BindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicySpec) {
+		r.Bindings = v
+	})
+}
 
-type ClusterWorkloadResourceMappingTemplateDie struct {
+var BindingPolicyWorkloadSelectorBlank = (&BindingPolicyWorkloadSelectorDie{}).DieFeed(apisv1beta1.BindingPolicyWorkloadSelector{})
+
+type BindingPolicyWorkloadSelectorDie struct {
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingTemplate
+	r       apisv1beta1.BindingPolicyWorkloadSelector
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DieImmutable(immutable bool) *BindingPolicyWorkloadSelectorDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -306,35 +328,35 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool)
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DieFeed(r apisv1beta1.BindingPolicyWorkloadSelector) *BindingPolicyWorkloadSelectorDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingTemplateDie{
+	return &BindingPolicyWorkloadSelectorDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DieFeedPtr(r *apisv1beta1.BindingPolicyWorkloadSelector) *BindingPolicyWorkloadSelectorDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+		r = &apisv1beta1.BindingPolicyWorkloadSelector{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyWorkloadSelectorDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	r := apisv1beta1.BindingPolicyWorkloadSelector{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+func (d *BindingPolicyWorkloadSelectorDie) DieRelease() apisv1beta1.BindingPolicyWorkloadSelector {
 	if d.mutable {
 		return d.r
 	}
@@ -342,13 +364,13 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.Clu
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+func (d *BindingPolicyWorkloadSelectorDie) DieReleasePtr() *apisv1beta1.BindingPolicyWorkloadSelector {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyWorkloadSelectorDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -357,58 +379,58 @@ func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() run
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DieStamp(fn func(r *apisv1beta1.BindingPolicyWorkloadSelector)) *BindingPolicyWorkloadSelectorDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+func (d *BindingPolicyWorkloadSelectorDie) DeepCopy() *BindingPolicyWorkloadSelectorDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingTemplateDie{
+	return &BindingPolicyWorkloadSelectorDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Version is the version of the workload resource that this mapping is for.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Version = v
+// API version of the workload resource.
+func (d *BindingPolicyWorkloadSelectorDie) APIVersion(v string) *BindingPolicyWorkloadSelectorDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadSelector) {
+		r.APIVersion = v
 	})
 }
 
-// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These annotations must end up in the resulting Pod, and are generally not the workload resource's annotations. Defaults to `.spec.template.metadata.annotations`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Annotations = v
+// Kind of the workload resource. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *BindingPolicyWorkloadSelectorDie) Kind(v string) *BindingPolicyWorkloadSelectorDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadSelector) {
+		r.Kind = v
 	})
 }
 
-// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to mappings appropriate for a PodSpecable resource.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Containers = v
+// Selector is a query that selects the workloads in the namespace of the policy
+func (d *BindingPolicyWorkloadSelectorDie) Selector(v metav1.LabelSelector) *BindingPolicyWorkloadSelectorDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadSelector) {
+		r.Selector = v
 	})
 }
 
-// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to `.spec.template.spec.volumes`.
-func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
-		r.Volumes = v
+// Containers describes which containers in a Pod should be bound to
+func (d *BindingPolicyWorkloadSelectorDie) Containers(v ...string) *BindingPolicyWorkloadSelectorDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadSelector) {
+		r.Containers = v
 	})
 }
 
-var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingContainer{})
+var BindingPolicyBindingBlank = (&BindingPolicyBindingDie{}).DieFeed(apisv1beta1.BindingPolicyBinding{})
 
-type ClusterWorkloadResourceMappingContainerDie struct {
+type BindingPolicyBindingDie struct {
 	mutable bool
-	r       apisv1beta1.ClusterWorkloadResourceMappingContainer
+	r       apisv1beta1.BindingPolicyBinding
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DieImmutable(immutable bool) *BindingPolicyBindingDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -418,35 +440,35 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DieFeed(r apisv1beta1.BindingPolicyBinding) *BindingPolicyBindingDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &BindingPolicyBindingDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DieFeedPtr(r *apisv1beta1.BindingPolicyBinding) *BindingPolicyBindingDie {
 	if r == nil {
-		r = &apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+		r = &apisv1beta1.BindingPolicyBinding{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyBindingDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	r := apisv1beta1.BindingPolicyBinding{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingContainer {
+func (d *BindingPolicyBindingDie) DieRelease() apisv1beta1.BindingPolicyBinding {
 	if d.mutable {
 		return d.r
 	}
@@ -454,13 +476,13 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.Cl
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingContainer {
+func (d *BindingPolicyBindingDie) DieReleasePtr() *apisv1beta1.BindingPolicyBinding {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -469,59 +491,65 @@ func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() ru
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DieStamp(fn func(r *apisv1beta1.BindingPolicyBinding)) *BindingPolicyBindingDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+func (d *BindingPolicyBindingDie) DeepCopy() *BindingPolicyBindingDie {
 	r := *d.r.DeepCopy()
-	return &ClusterWorkloadResourceMappingContainerDie{
+	return &BindingPolicyBindingDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like.
-func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Path = v
+// Name is the name of the binding, used as the name of the binding directory within the workload. Must be unique within the policy.
+func (d *BindingPolicyBindingDie) Name(v string) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyBinding) {
+		r.Name = v
 	})
 }
 
-// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource fragment. If not defined, container name filtering is ignored.
-func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Name = v
+// Type is the type of the service as projected into the workload container
+func (d *BindingPolicyBindingDie) Type(v string) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyBinding) {
+		r.Type = v
 	})
 }
 
-// Env is a Restricted JSONPath that references the slice of environment variables for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.envs`.
-func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.Env = v
+// Provider is the provider of the service as projected into the workload container
+func (d *BindingPolicyBindingDie) Provider(v string) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyBinding) {
+		r.Provider = v
 	})
 }
 
-// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.volumeMounts`.
-func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
-	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
-		r.VolumeMounts = v
+// Service is a reference to an object that fulfills the ProvisionedService duck type
+func (d *BindingPolicyBindingDie) Service(v apisv1beta1.ServiceBindingServiceReference) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyBinding) {
+		r.Service = v
 	})
 }
 
-var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1beta1.ServiceBinding{})
+// Env is the collection of mappings from Secret entries to environment variables
+func (d *BindingPolicyBindingDie) Env(v ...apisv1beta1.EnvMapping) *BindingPolicyBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyBinding) {
+		r.Env = v
+	})
+}
 
-type ServiceBindingDie struct {
-	v1.FrozenObjectMeta
+var BindingPolicyStatusBlank = (&BindingPolicyStatusDie{}).DieFeed(apisv1beta1.BindingPolicyStatus{})
+
+type BindingPolicyStatusDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBinding
+	r       apisv1beta1.BindingPolicyStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DieImmutable(immutable bool) *BindingPolicyStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -531,37 +559,35 @@ func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingDie) DieFeed(r apisv1beta1.ServiceBinding) *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DieFeed(r apisv1beta1.BindingPolicyStatus) *BindingPolicyStatusDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
+	return &BindingPolicyStatusDie{
+		mutable: d.mutable,
+		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingDie) DieFeedPtr(r *apisv1beta1.ServiceBinding) *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DieFeedPtr(r *apisv1beta1.BindingPolicyStatus) *BindingPolicyStatusDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBinding{}
+		r = &apisv1beta1.BindingPolicyStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyStatusDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBinding{}
+	r := apisv1beta1.BindingPolicyStatus{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
+func (d *BindingPolicyStatusDie) DieRelease() apisv1beta1.BindingPolicyStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -569,22 +595,13 @@ func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingDie) DieReleasePtr() *apisv1beta1.ServiceBinding {
+func (d *BindingPolicyStatusDie) DieReleasePtr() *apisv1beta1.BindingPolicyStatus {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
-func (d *ServiceBindingDie) DieReleaseUnstructured() runtime.Unstructured {
-	r := d.DieReleasePtr()
-	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -593,112 +610,58 @@ func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1beta1.ServiceBinding)) *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DieStamp(fn func(r *apisv1beta1.BindingPolicyStatus)) *BindingPolicyStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+func (d *BindingPolicyStatusDie) DeepCopy() *BindingPolicyStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-	}
-}
-
-var _ runtime.Object = (*ServiceBindingDie)(nil)
-
-func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
-	if d == ServiceBindingBlank {
-		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
-	}
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	return &BindingPolicyStatusDie{
+		mutable: d.mutable,
+		r:       r,
 	}
-	r := &apisv1beta1.ServiceBinding{}
-	err := json.Unmarshal(b, r)
-	*d = *d.DieFeed(*r)
-	return err
-}
-
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.APIVersion = v
-	})
-}
-
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.Kind = v
-	})
 }
 
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
-	})
-}
-
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
+// ObservedGeneration is the 'Generation' of the BindingPolicy that was last processed by the controller.
+func (d *BindingPolicyStatusDie) ObservedGeneration(v int64) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyStatus) {
+		r.ObservedGeneration = v
 	})
 }
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
+// Conditions are the conditions of this BindingPolicy
+func (d *BindingPolicyStatusDie) Conditions(v ...metav1.Condition) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyStatus) {
+		r.Conditions = v
 	})
 }
 
-func (d *ServiceBindingDie) Spec(v apisv1beta1.ServiceBindingSpec) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.Spec = v
+// ServiceBindings reports the ServiceBindings generated for each binding and workload selector
+func (d *BindingPolicyStatusDie) ServiceBindings(v ...apisv1beta1.BindingPolicyServiceBindingStatus) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyStatus) {
+		r.ServiceBindings = v
 	})
 }
 
-func (d *ServiceBindingDie) Status(v apisv1beta1.ServiceBindingStatus) *ServiceBindingDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
-		r.Status = v
+// Workloads reports the aggregate state of the bindings for each selected workload
+func (d *BindingPolicyStatusDie) Workloads(v ...apisv1beta1.BindingPolicyWorkloadStatus) *BindingPolicyStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyStatus) {
+		r.Workloads = v
 	})
 }
 
-var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1beta1.ServiceBindingSpec{})
+var BindingPolicyServiceBindingStatusBlank = (&BindingPolicyServiceBindingStatusDie{}).DieFeed(apisv1beta1.BindingPolicyServiceBindingStatus{})
 
-type ServiceBindingSpecDie struct {
+type BindingPolicyServiceBindingStatusDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingSpec
+	r       apisv1beta1.BindingPolicyServiceBindingStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DieImmutable(immutable bool) *BindingPolicyServiceBindingStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -708,35 +671,35 @@ func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpec
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSpecDie) DieFeed(r apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DieFeed(r apisv1beta1.BindingPolicyServiceBindingStatus) *BindingPolicyServiceBindingStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSpecDie{
+	return &BindingPolicyServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.BindingPolicyServiceBindingStatus) *BindingPolicyServiceBindingStatusDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingSpec{}
+		r = &apisv1beta1.BindingPolicyServiceBindingStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyServiceBindingStatusDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingSpec{}
+	r := apisv1beta1.BindingPolicyServiceBindingStatus{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
+func (d *BindingPolicyServiceBindingStatusDie) DieRelease() apisv1beta1.BindingPolicyServiceBindingStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -744,13 +707,13 @@ func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingSpec {
+func (d *BindingPolicyServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.BindingPolicyServiceBindingStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -759,72 +722,58 @@ func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.BindingPolicyServiceBindingStatus)) *BindingPolicyServiceBindingStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+func (d *BindingPolicyServiceBindingStatusDie) DeepCopy() *BindingPolicyServiceBindingStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSpecDie{
+	return &BindingPolicyServiceBindingStatusDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
-func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+// Name of the generated ServiceBinding
+func (d *BindingPolicyServiceBindingStatusDie) Name(v string) *BindingPolicyServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyServiceBindingStatus) {
 		r.Name = v
 	})
 }
 
-// Type is the type of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Type = v
-	})
-}
-
-// Provider is the provider of the service as projected into the workload container
-func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Provider = v
-	})
-}
-
-// Workload is a reference to an object
-func (d *ServiceBindingSpecDie) Workload(v apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Workload = v
+// Binding is the name of the binding in the policy
+func (d *BindingPolicyServiceBindingStatusDie) Binding(v string) *BindingPolicyServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyServiceBindingStatus) {
+		r.Binding = v
 	})
 }
 
-// Service is a reference to an object that fulfills the ProvisionedService duck type
-func (d *ServiceBindingSpecDie) Service(v apisv1beta1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Service = v
+// Ready is the status of the Ready condition of the ServiceBinding
+func (d *BindingPolicyServiceBindingStatusDie) Ready(v metav1.ConditionStatus) *BindingPolicyServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyServiceBindingStatus) {
+		r.Ready = v
 	})
 }
 
-// Env is the collection of mappings from Secret entries to environment variables
-func (d *ServiceBindingSpecDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingSpecDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
-		r.Env = v
+// Reason is the reason of the Ready condition of the ServiceBinding
+func (d *BindingPolicyServiceBindingStatusDie) Reason(v string) *BindingPolicyServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyServiceBindingStatus) {
+		r.Reason = v
 	})
 }
 
-var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingWorkloadReference{})
+var BindingPolicyWorkloadStatusBlank = (&BindingPolicyWorkloadStatusDie{}).DieFeed(apisv1beta1.BindingPolicyWorkloadStatus{})
 
-type ServiceBindingWorkloadReferenceDie struct {
+type BindingPolicyWorkloadStatusDie struct {
 	mutable bool
-	r       apisv1beta1.ServiceBindingWorkloadReference
+	r       apisv1beta1.BindingPolicyWorkloadStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DieImmutable(immutable bool) *BindingPolicyWorkloadStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -834,35 +783,35 @@ func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *Servi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DieFeed(r apisv1beta1.BindingPolicyWorkloadStatus) *BindingPolicyWorkloadStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingWorkloadReferenceDie{
+	return &BindingPolicyWorkloadStatusDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DieFeedPtr(r *apisv1beta1.BindingPolicyWorkloadStatus) *BindingPolicyWorkloadStatusDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingWorkloadReference{}
+		r = &apisv1beta1.BindingPolicyWorkloadStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingPolicyWorkloadStatusDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingWorkloadReference{}
+	r := apisv1beta1.BindingPolicyWorkloadStatus{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBindingWorkloadReference {
+func (d *BindingPolicyWorkloadStatusDie) DieRelease() apisv1beta1.BindingPolicyWorkloadStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -870,13 +819,13 @@ func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBin
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingWorkloadReference {
+func (d *BindingPolicyWorkloadStatusDie) DieReleasePtr() *apisv1beta1.BindingPolicyWorkloadStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingPolicyWorkloadStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -885,65 +834,66 @@ func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.Ra
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DieStamp(fn func(r *apisv1beta1.BindingPolicyWorkloadStatus)) *BindingPolicyWorkloadStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+func (d *BindingPolicyWorkloadStatusDie) DeepCopy() *BindingPolicyWorkloadStatusDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingWorkloadReferenceDie{
+	return &BindingPolicyWorkloadStatusDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// API version of the referent.
-func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+// API version of the workload.
+func (d *BindingPolicyWorkloadStatusDie) APIVersion(v string) *BindingPolicyWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadStatus) {
 		r.APIVersion = v
 	})
 }
 
-// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+// Kind of the workload.
+func (d *BindingPolicyWorkloadStatusDie) Kind(v string) *BindingPolicyWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadStatus) {
 		r.Kind = v
 	})
 }
 
-// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+// Name of the workload.
+func (d *BindingPolicyWorkloadStatusDie) Name(v string) *BindingPolicyWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadStatus) {
 		r.Name = v
 	})
 }
 
-// Selector is a query that selects the workload or workloads to bind the service to
-func (d *ServiceBindingWorkloadReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Selector = v
+// Ready is True when every binding for the workload is ready, False when any binding failed, otherwise Unknown
+func (d *BindingPolicyWorkloadStatusDie) Ready(v metav1.ConditionStatus) *BindingPolicyWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadStatus) {
+		r.Ready = v
 	})
 }
 
-// Containers describes which containers in a Pod should be bound to
-func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
-		r.Containers = v
+// NotReadyBindings are the names of the bindings for the workload that are not ready
+func (d *BindingPolicyWorkloadStatusDie) NotReadyBindings(v ...string) *BindingPolicyWorkloadStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingPolicyWorkloadStatus) {
+		r.NotReadyBindings = v
 	})
 }
 
-var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingServiceReference{})
+var BindingTypeSchemaBlank = (&BindingTypeSchemaDie{}).DieFeed(apisv1beta1.BindingTypeSchema{})
 
-type ServiceBindingServiceReferenceDie struct {
+type BindingTypeSchemaDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ServiceBindingServiceReference
+	r       apisv1beta1.BindingTypeSchema
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DieImmutable(immutable bool) *BindingTypeSchemaDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -953,35 +903,37 @@ func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *Servic
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DieFeed(r apisv1beta1.BindingTypeSchema) *BindingTypeSchemaDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingServiceReferenceDie{
-		mutable: d.mutable,
-		r:       r,
+	return &BindingTypeSchemaDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DieFeedPtr(r *apisv1beta1.BindingTypeSchema) *BindingTypeSchemaDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingServiceReference{}
+		r = &apisv1beta1.BindingTypeSchema{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingTypeSchemaDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingServiceReference{}
+	r := apisv1beta1.BindingTypeSchema{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBindingServiceReference {
+func (d *BindingTypeSchemaDie) DieRelease() apisv1beta1.BindingTypeSchema {
 	if d.mutable {
 		return d.r
 	}
@@ -989,13 +941,22 @@ func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBind
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceReference {
+func (d *BindingTypeSchemaDie) DieReleasePtr() *apisv1beta1.BindingTypeSchema {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *BindingTypeSchemaDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingTypeSchemaDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1004,51 +965,97 @@ func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.Raw
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DieStamp(fn func(r *apisv1beta1.BindingTypeSchema)) *BindingTypeSchemaDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+func (d *BindingTypeSchemaDie) DeepCopy() *BindingTypeSchemaDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingServiceReferenceDie{
-		mutable: d.mutable,
-		r:       r,
+	return &BindingTypeSchemaDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
-// API version of the referent.
-func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+var _ runtime.Object = (*BindingTypeSchemaDie)(nil)
+
+func (d *BindingTypeSchemaDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *BindingTypeSchemaDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *BindingTypeSchemaDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *BindingTypeSchemaDie) UnmarshalJSON(b []byte) error {
+	if d == BindingTypeSchemaBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.BindingTypeSchema{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *BindingTypeSchemaDie) APIVersion(v string) *BindingTypeSchemaDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchema) {
 		r.APIVersion = v
 	})
 }
 
-// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *BindingTypeSchemaDie) Kind(v string) *BindingTypeSchemaDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchema) {
 		r.Kind = v
 	})
 }
 
-// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
-		r.Name = v
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *BindingTypeSchemaDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *BindingTypeSchemaDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchema) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
 	})
 }
 
-var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1beta1.EnvMapping{})
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *BindingTypeSchemaDie) SpecDie(fn func(d *BindingTypeSchemaSpecDie)) *BindingTypeSchemaDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchema) {
+		d := BindingTypeSchemaSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
 
-type EnvMappingDie struct {
+func (d *BindingTypeSchemaDie) Spec(v apisv1beta1.BindingTypeSchemaSpec) *BindingTypeSchemaDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchema) {
+		r.Spec = v
+	})
+}
+
+var BindingTypeSchemaSpecBlank = (&BindingTypeSchemaSpecDie{}).DieFeed(apisv1beta1.BindingTypeSchemaSpec{})
+
+type BindingTypeSchemaSpecDie struct {
 	mutable bool
-	r       apisv1beta1.EnvMapping
+	r       apisv1beta1.BindingTypeSchemaSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DieImmutable(immutable bool) *BindingTypeSchemaSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1058,35 +1065,35 @@ func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *EnvMappingDie) DieFeed(r apisv1beta1.EnvMapping) *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DieFeed(r apisv1beta1.BindingTypeSchemaSpec) *BindingTypeSchemaSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &EnvMappingDie{
+	return &BindingTypeSchemaSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *EnvMappingDie) DieFeedPtr(r *apisv1beta1.EnvMapping) *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DieFeedPtr(r *apisv1beta1.BindingTypeSchemaSpec) *BindingTypeSchemaSpecDie {
 	if r == nil {
-		r = &apisv1beta1.EnvMapping{}
+		r = &apisv1beta1.BindingTypeSchemaSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *BindingTypeSchemaSpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.EnvMapping{}
+	r := apisv1beta1.BindingTypeSchemaSpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
+func (d *BindingTypeSchemaSpecDie) DieRelease() apisv1beta1.BindingTypeSchemaSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -1094,13 +1101,13 @@ func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *EnvMappingDie) DieReleasePtr() *apisv1beta1.EnvMapping {
+func (d *BindingTypeSchemaSpecDie) DieReleasePtr() *apisv1beta1.BindingTypeSchemaSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *BindingTypeSchemaSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1109,44 +1116,45 @@ func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *EnvMappingDie) DieStamp(fn func(r *apisv1beta1.EnvMapping)) *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DieStamp(fn func(r *apisv1beta1.BindingTypeSchemaSpec)) *BindingTypeSchemaSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+func (d *BindingTypeSchemaSpecDie) DeepCopy() *BindingTypeSchemaSpecDie {
 	r := *d.r.DeepCopy()
-	return &EnvMappingDie{
+	return &BindingTypeSchemaSpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name is the name of the environment variable
-func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
-		r.Name = v
+// RequiredKeys are the entries that must be present in a binding of the type, like `host` or `port`.
+func (d *BindingTypeSchemaSpecDie) RequiredKeys(v ...string) *BindingTypeSchemaSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchemaSpec) {
+		r.RequiredKeys = v
 	})
 }
 
-// Key is the key in the Secret that will be exposed
-func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
-	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
-		r.Key = v
+// OptionalKeys are the entries that may be present in a binding of the type. Entries that are neither required nor optional are permitted, the optional keys document the well-known entries of the type.
+func (d *BindingTypeSchemaSpecDie) OptionalKeys(v ...string) *BindingTypeSchemaSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.BindingTypeSchemaSpec) {
+		r.OptionalKeys = v
 	})
 }
 
-var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1beta1.ServiceBindingStatus{})
+var ClusterServiceResourceMappingBlank = (&ClusterServiceResourceMappingDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMapping{})
 
-type ServiceBindingStatusDie struct {
+type ClusterServiceResourceMappingDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       apisv1beta1.ServiceBindingStatus
+	r       apisv1beta1.ClusterServiceResourceMapping
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1156,35 +1164,37 @@ func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingSt
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingStatusDie) DieFeed(r apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DieFeed(r apisv1beta1.ClusterServiceResourceMapping) *ClusterServiceResourceMappingDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ServiceBindingStatusDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ClusterServiceResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMapping) *ClusterServiceResourceMappingDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingStatus{}
+		r = &apisv1beta1.ClusterServiceResourceMapping{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingStatus{}
+	r := apisv1beta1.ClusterServiceResourceMapping{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus {
+func (d *ClusterServiceResourceMappingDie) DieRelease() apisv1beta1.ClusterServiceResourceMapping {
 	if d.mutable {
 		return d.r
 	}
@@ -1192,13 +1202,22 @@ func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingStatus {
+func (d *ClusterServiceResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMapping {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterServiceResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterServiceResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1207,51 +1226,3201 @@ func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingStatus)) *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMapping)) *ClusterServiceResourceMappingDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
+func (d *ClusterServiceResourceMappingDie) DeepCopy() *ClusterServiceResourceMappingDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingStatusDie{
-		mutable: d.mutable,
-		r:       r,
+	return &ClusterServiceResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
 	}
 }
 
-// ObservedGeneration is the 'Generation' of the ServiceBinding that was last processed by the controller.
-func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.ObservedGeneration = v
-	})
+var _ runtime.Object = (*ClusterServiceResourceMappingDie)(nil)
+
+func (d *ClusterServiceResourceMappingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
 }
 
-// Conditions are the conditions of this ServiceBinding
-func (d *ServiceBindingStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.Conditions = v
-	})
+func (d *ClusterServiceResourceMappingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
 }
 
-// Binding exposes the projected secret for this ServiceBinding
-func (d *ServiceBindingStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
-		r.Binding = v
-	})
+func (d *ClusterServiceResourceMappingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
 }
 
-var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingSecretReference{})
+func (d *ClusterServiceResourceMappingDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterServiceResourceMappingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ClusterServiceResourceMapping{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
 
-type ServiceBindingSecretReferenceDie struct {
-	mutable bool
-	r       apisv1beta1.ServiceBindingSecretReference
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterServiceResourceMappingDie) APIVersion(v string) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		r.APIVersion = v
+	})
 }
 
-// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterServiceResourceMappingDie) Kind(v string) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterServiceResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ClusterServiceResourceMappingDie) SpecDie(fn func(d *ClusterServiceResourceMappingSpecDie)) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		d := ClusterServiceResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ClusterServiceResourceMappingDie) Spec(v apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMapping) {
+		r.Spec = v
+	})
+}
+
+var ClusterServiceResourceMappingSpecBlank = (&ClusterServiceResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingSpec{})
+
+type ClusterServiceResourceMappingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingSpec) *ClusterServiceResourceMappingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingSpec)) *ClusterServiceResourceMappingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingSpecDie) DeepCopy() *ClusterServiceResourceMappingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterServiceResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingSpec) {
+		r.Versions = v
+	})
+}
+
+var ClusterServiceResourceMappingTemplateBlank = (&ClusterServiceResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingTemplate{})
+
+type ClusterServiceResourceMappingTemplateDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingTemplate) *ClusterServiceResourceMappingTemplateDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingTemplateDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingTemplate{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingTemplate)) *ClusterServiceResourceMappingTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingTemplateDie) DeepCopy() *ClusterServiceResourceMappingTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Version is the version of the service resource that this mapping is for. Versions are matched with the same patterns and precedence as a ClusterWorkloadResourceMapping.
+func (d *ClusterServiceResourceMappingTemplateDie) Version(v string) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.Version = v
+	})
+}
+
+// SecretName is a Restricted JSONPath that references the name of a Secret in the namespace of the service that contains the binding, like `.spec.writeConnectionSecretToRef.name`. May not be set with data.
+func (d *ClusterServiceResourceMappingTemplateDie) SecretName(v string) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.SecretName = v
+	})
+}
+
+// Data are the entries of a binding Secret synthesized from the fields of the service. The synthesized Secret is owned by the ServiceBinding. May not be set with secretName.
+func (d *ClusterServiceResourceMappingTemplateDie) Data(v ...apisv1beta1.ClusterServiceResourceMappingData) *ClusterServiceResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingTemplate) {
+		r.Data = v
+	})
+}
+
+var ClusterServiceResourceMappingDataBlank = (&ClusterServiceResourceMappingDataDie{}).DieFeed(apisv1beta1.ClusterServiceResourceMappingData{})
+
+type ClusterServiceResourceMappingDataDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterServiceResourceMappingData
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterServiceResourceMappingDataDie) DieImmutable(immutable bool) *ClusterServiceResourceMappingDataDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterServiceResourceMappingDataDie) DieFeed(r apisv1beta1.ClusterServiceResourceMappingData) *ClusterServiceResourceMappingDataDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterServiceResourceMappingDataDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterServiceResourceMappingDataDie) DieFeedPtr(r *apisv1beta1.ClusterServiceResourceMappingData) *ClusterServiceResourceMappingDataDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterServiceResourceMappingData{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingDataDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterServiceResourceMappingDataDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterServiceResourceMappingData{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterServiceResourceMappingDataDie) DieRelease() apisv1beta1.ClusterServiceResourceMappingData {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterServiceResourceMappingDataDie) DieReleasePtr() *apisv1beta1.ClusterServiceResourceMappingData {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterServiceResourceMappingDataDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterServiceResourceMappingDataDie) DieStamp(fn func(r *apisv1beta1.ClusterServiceResourceMappingData)) *ClusterServiceResourceMappingDataDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterServiceResourceMappingDataDie) DeepCopy() *ClusterServiceResourceMappingDataDie {
+	r := *d.r.DeepCopy()
+	return &ClusterServiceResourceMappingDataDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Key is the name of the entry in the binding Secret.
+func (d *ClusterServiceResourceMappingDataDie) Key(v string) *ClusterServiceResourceMappingDataDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingData) {
+		r.Key = v
+	})
+}
+
+// Value is a Go text/template evaluated against the service resource, like `{{ .status.host }}`. Referencing a field that does not exist in the service, rendering more than 1MiB or executing for more than a second is an error.
+func (d *ClusterServiceResourceMappingDataDie) Value(v string) *ClusterServiceResourceMappingDataDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterServiceResourceMappingData) {
+		r.Value = v
+	})
+}
+
+var ClusterWorkloadResourceMappingBlank = (&ClusterWorkloadResourceMappingDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMapping{})
+
+type ClusterWorkloadResourceMappingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMapping) *ClusterWorkloadResourceMappingDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMapping)) *ClusterWorkloadResourceMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) DeepCopy() *ClusterWorkloadResourceMappingDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ClusterWorkloadResourceMappingDie)(nil)
+
+func (d *ClusterWorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterWorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterWorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterWorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
+	if d == ClusterWorkloadResourceMappingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ClusterWorkloadResourceMapping{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterWorkloadResourceMappingDie) APIVersion(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterWorkloadResourceMappingDie) Kind(v string) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ClusterWorkloadResourceMappingDie) StatusDie(fn func(d *ClusterWorkloadResourceMappingStatusDie)) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		d := ClusterWorkloadResourceMappingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ClusterWorkloadResourceMappingDie) Spec(v apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterWorkloadResourceMappingDie) Status(v apisv1beta1.ClusterWorkloadResourceMappingStatus) *ClusterWorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMapping) {
+		r.Status = v
+	})
+}
+
+var ClusterWorkloadResourceMappingSpecBlank = (&ClusterWorkloadResourceMappingSpecDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingSpec{})
+
+type ClusterWorkloadResourceMappingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingSpecDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) *ClusterWorkloadResourceMappingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingSpecDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec)) *ClusterWorkloadResourceMappingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingSpecDie) DeepCopy() *ClusterWorkloadResourceMappingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Versions is the collection of versions for a given resource, with mappings.
+func (d *ClusterWorkloadResourceMappingSpecDie) Versions(v ...apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingSpec) {
+		r.Versions = v
+	})
+}
+
+var ClusterWorkloadResourceMappingTemplateBlank = (&ClusterWorkloadResourceMappingTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingTemplate{})
+
+type ClusterWorkloadResourceMappingTemplateDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingTemplateDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate)) *ClusterWorkloadResourceMappingTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Version is the version of the workload resource that this mapping is for. The version may be an exact version like `v1`, a range of Kubernetes versions ordered by priority like `>=v1beta1` or `>=v1alpha1 <v1`, a glob like `v1beta*` or `*` for all versions. When more than one version matches, an exact version is preferred over a range, a range over a glob and a glob over `*`. Ranges may not overlap other ranges, and globs other globs.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Version(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Version = v
+	})
+}
+
+// Annotations is a Restricted JSONPath that references the annotations map within the workload resource. These annotations must end up in the resulting Pod, and are generally not the workload resource's annotations. Defaults to `.spec.template.metadata.annotations`. Restricted JSONPaths are composed of fields, single array indices and filters, and must end with a field. A filter selects the first matching array element.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Annotations = v
+	})
+}
+
+// Containers is the collection of mappings to container-like fragments of the workload resource. Defaults to mappings appropriate for a PodSpecable resource.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Containers = v
+	})
+}
+
+// Volumes is a Restricted JSONPath that references the slice of volumes within the workload resource. Defaults to `.spec.template.spec.volumes`.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Volumes = v
+	})
+}
+
+// Labels is a Restricted JSONPath that references the labels map within the workload resource. These labels must end up in the resulting Pod, and are generally not the workload resource's labels. Defaults to `.spec.template.metadata.labels` when the annotations are also defaulted, otherwise labels are not projected.
+func (d *ClusterWorkloadResourceMappingTemplateDie) Labels(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.Labels = v
+	})
+}
+
+// ImagePullSecrets is a Restricted JSONPath that references the slice of image pull secrets within the workload resource. Defaults to `.spec.template.spec.imagePullSecrets` when the volumes are also defaulted, otherwise image pull secrets are not projected.
+func (d *ClusterWorkloadResourceMappingTemplateDie) ImagePullSecrets(v string) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.ImagePullSecrets = v
+	})
+}
+
+// PodTemplates is the collection of mappings for workload resources that contain more than one pod template. Each pod template has its own annotations, containers and volumes, and is projected independently. When defined, the annotations, containers and volumes of the version must not be defined and are not defaulted.
+func (d *ClusterWorkloadResourceMappingTemplateDie) PodTemplates(v ...apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingTemplate) {
+		r.PodTemplates = v
+	})
+}
+
+var ClusterWorkloadResourceMappingPodTemplateBlank = (&ClusterWorkloadResourceMappingPodTemplateDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{})
+
+type ClusterWorkloadResourceMappingPodTemplateDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingPodTemplate
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingPodTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) *ClusterWorkloadResourceMappingPodTemplateDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingPodTemplateDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingPodTemplate{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingPodTemplate {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate)) *ClusterWorkloadResourceMappingPodTemplateDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) DeepCopy() *ClusterWorkloadResourceMappingPodTemplateDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingPodTemplateDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name identifies the pod template within the workload resource. ServiceBindings may target specific pod templates by name.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Name(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Name = v
+	})
+}
+
+// Annotations is a Restricted JSONPath that references the annotations map for the pod template within the workload resource.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Annotations(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Annotations = v
+	})
+}
+
+// Containers is the collection of mappings to container-like fragments of the pod template.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Containers(v ...apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Containers = v
+	})
+}
+
+// Volumes is a Restricted JSONPath that references the slice of volumes for the pod template within the workload resource.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Volumes(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Volumes = v
+	})
+}
+
+// Labels is a Restricted JSONPath that references the labels map for the pod template within the workload resource. Labels are not projected if not defined.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) Labels(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.Labels = v
+	})
+}
+
+// ImagePullSecrets is a Restricted JSONPath that references the slice of image pull secrets for the pod template within the workload resource. Image pull secrets are not projected if not defined.
+func (d *ClusterWorkloadResourceMappingPodTemplateDie) ImagePullSecrets(v string) *ClusterWorkloadResourceMappingPodTemplateDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingPodTemplate) {
+		r.ImagePullSecrets = v
+	})
+}
+
+var ClusterWorkloadResourceMappingContainerBlank = (&ClusterWorkloadResourceMappingContainerDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingContainer{})
+
+type ClusterWorkloadResourceMappingContainerDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingContainer
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingContainerDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) *ClusterWorkloadResourceMappingContainerDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingContainerDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingContainer{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingContainer {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingContainer {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingContainerDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer)) *ClusterWorkloadResourceMappingContainerDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingContainerDie) DeepCopy() *ClusterWorkloadResourceMappingContainerDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingContainerDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Path is the JSONPath within the workload resource that matches an existing fragment that is container-like. Array indices, slices, filters, wildcards and unions may be used. Entries of a map-valued collection are matched in key order.
+func (d *ClusterWorkloadResourceMappingContainerDie) Path(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Path = v
+	})
+}
+
+// Name is a Restricted JSONPath that references the name of the container with the container-like workload resource fragment. If not defined, the container is not bound when the ServiceBinding selects containers by name.
+func (d *ClusterWorkloadResourceMappingContainerDie) Name(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Name = v
+	})
+}
+
+// Env is a Restricted JSONPath that references the slice of environment variables for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.envs`.
+func (d *ClusterWorkloadResourceMappingContainerDie) Env(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.Env = v
+	})
+}
+
+// VolumeMounts is a Restricted JSONPath that references the slice of volume mounts for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.volumeMounts`.
+func (d *ClusterWorkloadResourceMappingContainerDie) VolumeMounts(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.VolumeMounts = v
+	})
+}
+
+// EnvFrom is a Restricted JSONPath that references the slice of environment variable sources for the container with the container-like workload resource fragment. The referenced location is created if it does not exist. Defaults to `.envFrom`.
+func (d *ClusterWorkloadResourceMappingContainerDie) EnvFrom(v string) *ClusterWorkloadResourceMappingContainerDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingContainer) {
+		r.EnvFrom = v
+	})
+}
+
+var ClusterWorkloadResourceMappingStatusBlank = (&ClusterWorkloadResourceMappingStatusDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingStatus{})
+
+type ClusterWorkloadResourceMappingStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingStatusDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingStatus) *ClusterWorkloadResourceMappingStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) *ClusterWorkloadResourceMappingStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingStatusDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus)) *ClusterWorkloadResourceMappingStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingStatusDie) DeepCopy() *ClusterWorkloadResourceMappingStatusDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// ObservedGeneration is the 'Generation' of the ClusterWorkloadResourceMapping that was last processed by the controller.
+func (d *ClusterWorkloadResourceMappingStatusDie) ObservedGeneration(v int64) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.ObservedGeneration = v
+	})
+}
+
+// Conditions are the conditions of this ClusterWorkloadResourceMapping
+func (d *ClusterWorkloadResourceMappingStatusDie) Conditions(v ...metav1.Condition) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.Conditions = v
+	})
+}
+
+// Versions reports how each mapped version resolves against the workload resource
+func (d *ClusterWorkloadResourceMappingStatusDie) Versions(v ...apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.Versions = v
+	})
+}
+
+// ServiceBindings is the number of ServiceBindings with a workload of the mapped resource
+func (d *ClusterWorkloadResourceMappingStatusDie) ServiceBindings(v int32) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.ServiceBindings = v
+	})
+}
+
+// MissingVerbs are the verbs the controller is not permitted to use on the mapped resource. Workloads of the resource are not able to be bound until the permissions are granted to the controller.
+func (d *ClusterWorkloadResourceMappingStatusDie) MissingVerbs(v ...string) *ClusterWorkloadResourceMappingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingStatus) {
+		r.MissingVerbs = v
+	})
+}
+
+var ClusterWorkloadResourceMappingVersionStatusBlank = (&ClusterWorkloadResourceMappingVersionStatusDie{}).DieFeed(apisv1beta1.ClusterWorkloadResourceMappingVersionStatus{})
+
+type ClusterWorkloadResourceMappingVersionStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ClusterWorkloadResourceMappingVersionStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieImmutable(immutable bool) *ClusterWorkloadResourceMappingVersionStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieFeed(r apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) *ClusterWorkloadResourceMappingVersionStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterWorkloadResourceMappingVersionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieFeedPtr(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) *ClusterWorkloadResourceMappingVersionStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ClusterWorkloadResourceMappingVersionStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterWorkloadResourceMappingVersionStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ClusterWorkloadResourceMappingVersionStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieRelease() apisv1beta1.ClusterWorkloadResourceMappingVersionStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieReleasePtr() *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DieStamp(fn func(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus)) *ClusterWorkloadResourceMappingVersionStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) DeepCopy() *ClusterWorkloadResourceMappingVersionStatusDie {
+	r := *d.r.DeepCopy()
+	return &ClusterWorkloadResourceMappingVersionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Version is the version of the workload resource, matching the mapped version.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) Version(v string) *ClusterWorkloadResourceMappingVersionStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) {
+		r.Version = v
+	})
+}
+
+// Served is true when the version is served for the workload resource. The wildcard version is served when any version of the workload resource is served.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) Served(v bool) *ClusterWorkloadResourceMappingVersionStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) {
+		r.Served = v
+	})
+}
+
+// PathsChecked is true when the paths of the mapping were checked against the schema of the workload resource. Only resources defined by a CustomResourceDefinition with a structural schema are checked.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) PathsChecked(v bool) *ClusterWorkloadResourceMappingVersionStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) {
+		r.PathsChecked = v
+	})
+}
+
+// UnresolvedPaths are the paths of the mapping that do not exist in the schema of the workload resource. Values written to these locations are likely to be pruned.
+func (d *ClusterWorkloadResourceMappingVersionStatusDie) UnresolvedPaths(v ...string) *ClusterWorkloadResourceMappingVersionStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ClusterWorkloadResourceMappingVersionStatus) {
+		r.UnresolvedPaths = v
+	})
+}
+
+var ReferenceGrantBlank = (&ReferenceGrantDie{}).DieFeed(apisv1beta1.ReferenceGrant{})
+
+type ReferenceGrantDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ReferenceGrant
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantDie) DieImmutable(immutable bool) *ReferenceGrantDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantDie) DieFeed(r apisv1beta1.ReferenceGrant) *ReferenceGrantDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantDie) DieFeedPtr(r *apisv1beta1.ReferenceGrant) *ReferenceGrantDie {
+	if r == nil {
+		r = &apisv1beta1.ReferenceGrant{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ReferenceGrant{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantDie) DieRelease() apisv1beta1.ReferenceGrant {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantDie) DieReleasePtr() *apisv1beta1.ReferenceGrant {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ReferenceGrantDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantDie) DieStamp(fn func(r *apisv1beta1.ReferenceGrant)) *ReferenceGrantDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantDie) DeepCopy() *ReferenceGrantDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ReferenceGrantDie)(nil)
+
+func (d *ReferenceGrantDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ReferenceGrantDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ReferenceGrantDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ReferenceGrantDie) UnmarshalJSON(b []byte) error {
+	if d == ReferenceGrantBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ReferenceGrant{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ReferenceGrantDie) APIVersion(v string) *ReferenceGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrant) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ReferenceGrantDie) Kind(v string) *ReferenceGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrant) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ReferenceGrantDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ReferenceGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrant) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ReferenceGrantDie) SpecDie(fn func(d *ReferenceGrantSpecDie)) *ReferenceGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrant) {
+		d := ReferenceGrantSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ReferenceGrantDie) Spec(v apisv1beta1.ReferenceGrantSpec) *ReferenceGrantDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrant) {
+		r.Spec = v
+	})
+}
+
+var ReferenceGrantSpecBlank = (&ReferenceGrantSpecDie{}).DieFeed(apisv1beta1.ReferenceGrantSpec{})
+
+type ReferenceGrantSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ReferenceGrantSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantSpecDie) DieImmutable(immutable bool) *ReferenceGrantSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantSpecDie) DieFeed(r apisv1beta1.ReferenceGrantSpec) *ReferenceGrantSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantSpecDie) DieFeedPtr(r *apisv1beta1.ReferenceGrantSpec) *ReferenceGrantSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ReferenceGrantSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ReferenceGrantSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantSpecDie) DieRelease() apisv1beta1.ReferenceGrantSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantSpecDie) DieReleasePtr() *apisv1beta1.ReferenceGrantSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantSpecDie) DieStamp(fn func(r *apisv1beta1.ReferenceGrantSpec)) *ReferenceGrantSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantSpecDie) DeepCopy() *ReferenceGrantSpecDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// From are the namespaces whose ServiceBindings may reference the services
+func (d *ReferenceGrantSpecDie) From(v ...apisv1beta1.ReferenceGrantFrom) *ReferenceGrantSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantSpec) {
+		r.From = v
+	})
+}
+
+// To are the services in the namespace of the grant that may be referenced
+func (d *ReferenceGrantSpecDie) To(v ...apisv1beta1.ReferenceGrantTo) *ReferenceGrantSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantSpec) {
+		r.To = v
+	})
+}
+
+var ReferenceGrantFromBlank = (&ReferenceGrantFromDie{}).DieFeed(apisv1beta1.ReferenceGrantFrom{})
+
+type ReferenceGrantFromDie struct {
+	mutable bool
+	r       apisv1beta1.ReferenceGrantFrom
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantFromDie) DieImmutable(immutable bool) *ReferenceGrantFromDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantFromDie) DieFeed(r apisv1beta1.ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantFromDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantFromDie) DieFeedPtr(r *apisv1beta1.ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if r == nil {
+		r = &apisv1beta1.ReferenceGrantFrom{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantFromDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ReferenceGrantFrom{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantFromDie) DieRelease() apisv1beta1.ReferenceGrantFrom {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantFromDie) DieReleasePtr() *apisv1beta1.ReferenceGrantFrom {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantFromDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantFromDie) DieStamp(fn func(r *apisv1beta1.ReferenceGrantFrom)) *ReferenceGrantFromDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantFromDie) DeepCopy() *ReferenceGrantFromDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantFromDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Namespace of the ServiceBindings
+func (d *ReferenceGrantFromDie) Namespace(v string) *ReferenceGrantFromDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantFrom) {
+		r.Namespace = v
+	})
+}
+
+var ReferenceGrantToBlank = (&ReferenceGrantToDie{}).DieFeed(apisv1beta1.ReferenceGrantTo{})
+
+type ReferenceGrantToDie struct {
+	mutable bool
+	r       apisv1beta1.ReferenceGrantTo
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantToDie) DieImmutable(immutable bool) *ReferenceGrantToDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantToDie) DieFeed(r apisv1beta1.ReferenceGrantTo) *ReferenceGrantToDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantToDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantToDie) DieFeedPtr(r *apisv1beta1.ReferenceGrantTo) *ReferenceGrantToDie {
+	if r == nil {
+		r = &apisv1beta1.ReferenceGrantTo{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantToDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantToDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ReferenceGrantTo{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantToDie) DieRelease() apisv1beta1.ReferenceGrantTo {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantToDie) DieReleasePtr() *apisv1beta1.ReferenceGrantTo {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ReferenceGrantToDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantToDie) DieStamp(fn func(r *apisv1beta1.ReferenceGrantTo)) *ReferenceGrantToDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantToDie) DeepCopy() *ReferenceGrantToDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantToDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Group of the referent, empty for the core API group.
+func (d *ReferenceGrantToDie) Group(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantTo) {
+		r.Group = v
+	})
+}
+
+// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ReferenceGrantToDie) Kind(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantTo) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent. When empty, every resource of the kind in the namespace may be referenced, including by a selector.
+func (d *ReferenceGrantToDie) Name(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *apisv1beta1.ReferenceGrantTo) {
+		r.Name = v
+	})
+}
+
+var ServiceBindingBlank = (&ServiceBindingDie{}).DieFeed(apisv1beta1.ServiceBinding{})
+
+type ServiceBindingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ServiceBinding
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingDie) DieImmutable(immutable bool) *ServiceBindingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingDie) DieFeed(r apisv1beta1.ServiceBinding) *ServiceBindingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingDie) DieFeedPtr(r *apisv1beta1.ServiceBinding) *ServiceBindingDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBinding{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBinding{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingDie) DieRelease() apisv1beta1.ServiceBinding {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingDie) DieReleasePtr() *apisv1beta1.ServiceBinding {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ServiceBindingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingDie) DieStamp(fn func(r *apisv1beta1.ServiceBinding)) *ServiceBindingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingDie) DeepCopy() *ServiceBindingDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ServiceBindingDie)(nil)
+
+func (d *ServiceBindingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ServiceBindingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ServiceBindingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ServiceBindingDie) UnmarshalJSON(b []byte) error {
+	if d == ServiceBindingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ServiceBinding{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ServiceBindingDie) APIVersion(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingDie) Kind(v string) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ServiceBindingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ServiceBindingDie) SpecDie(fn func(d *ServiceBindingSpecDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := ServiceBindingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ServiceBindingDie) StatusDie(fn func(d *ServiceBindingStatusDie)) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		d := ServiceBindingStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ServiceBindingDie) Spec(v apisv1beta1.ServiceBindingSpec) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Spec = v
+	})
+}
+
+func (d *ServiceBindingDie) Status(v apisv1beta1.ServiceBindingStatus) *ServiceBindingDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBinding) {
+		r.Status = v
+	})
+}
+
+var ServiceBindingSpecBlank = (&ServiceBindingSpecDie{}).DieFeed(apisv1beta1.ServiceBindingSpec{})
+
+type ServiceBindingSpecDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingSpecDie) DieImmutable(immutable bool) *ServiceBindingSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingSpecDie) DieFeed(r apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingSpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSpec) *ServiceBindingSpecDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSpecDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingSpec{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieRelease() apisv1beta1.ServiceBindingSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingSpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingSpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSpec)) *ServiceBindingSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingSpecDie) DeepCopy() *ServiceBindingSpecDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingSpecDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is the name of the service as projected into the workload container.  Defaults to .metadata.name.
+func (d *ServiceBindingSpecDie) Name(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Name = v
+	})
+}
+
+// Type is the type of the service as projected into the workload container
+func (d *ServiceBindingSpecDie) Type(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Type = v
+	})
+}
+
+// Provider is the provider of the service as projected into the workload container
+func (d *ServiceBindingSpecDie) Provider(v string) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Provider = v
+	})
+}
+
+// Workload is a reference to an object
+func (d *ServiceBindingSpecDie) Workload(v apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Workload = v
+	})
+}
+
+// Service is a reference to an object that fulfills the ProvisionedService duck type. A Secret or ConfigMap may be referenced directly, a ConfigMap is intended for bindings without sensitive values
+func (d *ServiceBindingSpecDie) Service(v apisv1beta1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Service = v
+	})
+}
+
+// FallbackServices is an ordered collection of references to services that are used when the service, and each prior fallback service, does not provide a binding secret
+func (d *ServiceBindingSpecDie) FallbackServices(v ...apisv1beta1.ServiceBindingServiceReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.FallbackServices = v
+	})
+}
+
+// AdditionalServices is the collection of services whose binding secrets are projected into the same binding as the service. Environment variables are only mapped from the entries of the service.
+func (d *ServiceBindingSpecDie) AdditionalServices(v ...apisv1beta1.ServiceBindingAdditionalServiceReference) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.AdditionalServices = v
+	})
+}
+
+// Env is the collection of mappings from Secret or ConfigMap entries to environment variables
+func (d *ServiceBindingSpecDie) Env(v ...apisv1beta1.EnvMapping) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Env = v
+	})
+}
+
+// Transform derives the entries of the projected binding secret from the entries of the service's binding secret. The transformed entries are materialized into a Secret owned by the ServiceBinding.
+func (d *ServiceBindingSpecDie) Transform(v *apisv1beta1.ServiceBindingTransform) *ServiceBindingSpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSpec) {
+		r.Transform = v
+	})
+}
+
+var ServiceBindingWorkloadReferenceBlank = (&ServiceBindingWorkloadReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingWorkloadReference{})
+
+type ServiceBindingWorkloadReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingWorkloadReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingWorkloadReferenceDie) DieImmutable(immutable bool) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeed(r apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingWorkloadReference) *ServiceBindingWorkloadReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingWorkloadReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingWorkloadReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingWorkloadReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingWorkloadReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieRelease() apisv1beta1.ServiceBindingWorkloadReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingWorkloadReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingWorkloadReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingWorkloadReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingWorkloadReference)) *ServiceBindingWorkloadReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingWorkloadReferenceDie) DeepCopy() *ServiceBindingWorkloadReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingWorkloadReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// API version of the referent.
+func (d *ServiceBindingWorkloadReferenceDie) APIVersion(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingWorkloadReferenceDie) Kind(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Kind = v
+	})
+}
+
+// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingWorkloadReferenceDie) Name(v string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Name = v
+	})
+}
+
+// Selector is a query that selects the workload or workloads to bind the service to
+func (d *ServiceBindingWorkloadReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Selector = v
+	})
+}
+
+// Containers describes which containers in a Pod should be bound to
+func (d *ServiceBindingWorkloadReferenceDie) Containers(v ...string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.Containers = v
+	})
+}
+
+// PodTemplates describes which named pod templates in the workload should be bound to. Only applies to workloads whose mapping defines pod templates, all pod templates are bound to when empty.
+func (d *ServiceBindingWorkloadReferenceDie) PodTemplates(v ...string) *ServiceBindingWorkloadReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingWorkloadReference) {
+		r.PodTemplates = v
+	})
+}
+
+var ServiceBindingServiceReferenceBlank = (&ServiceBindingServiceReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingServiceReference{})
+
+type ServiceBindingServiceReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingServiceReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingServiceReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingServiceReferenceDie) DieFeed(r apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingServiceReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingServiceReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingServiceReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingServiceReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingServiceReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieRelease() apisv1beta1.ServiceBindingServiceReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingServiceReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingServiceReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingServiceReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingServiceReference)) *ServiceBindingServiceReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingServiceReferenceDie) DeepCopy() *ServiceBindingServiceReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// API version of the referent.
+func (d *ServiceBindingServiceReferenceDie) APIVersion(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingServiceReferenceDie) Kind(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Kind = v
+	})
+}
+
+// Namespace of the referent, defaults to the namespace of the ServiceBinding. A service in another namespace must be granted to the namespace of the ServiceBinding by a ReferenceGrant in the namespace of the service, the binding secret of the service is copied into the namespace of the ServiceBinding. Binding config maps are not copied.
+func (d *ServiceBindingServiceReferenceDie) Namespace(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Namespace = v
+	})
+}
+
+// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingServiceReferenceDie) Name(v string) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Name = v
+	})
+}
+
+// Selector is a query that selects the service within the namespace. When more than one service matches, services are preferred by creation timestamp, oldest first, and then by name. The first service with a binding secret is used.
+func (d *ServiceBindingServiceReferenceDie) Selector(v *metav1.LabelSelector) *ServiceBindingServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingServiceReference) {
+		r.Selector = v
+	})
+}
+
+var ServiceBindingAdditionalServiceReferenceBlank = (&ServiceBindingAdditionalServiceReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingAdditionalServiceReference{})
+
+type ServiceBindingAdditionalServiceReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingAdditionalServiceReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieImmutable(immutable bool) *ServiceBindingAdditionalServiceReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieFeed(r apisv1beta1.ServiceBindingAdditionalServiceReference) *ServiceBindingAdditionalServiceReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingAdditionalServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingAdditionalServiceReference) *ServiceBindingAdditionalServiceReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingAdditionalServiceReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingAdditionalServiceReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingAdditionalServiceReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieRelease() apisv1beta1.ServiceBindingAdditionalServiceReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingAdditionalServiceReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingAdditionalServiceReference)) *ServiceBindingAdditionalServiceReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingAdditionalServiceReferenceDie) DeepCopy() *ServiceBindingAdditionalServiceReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingAdditionalServiceReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Path is the subdirectory within the binding that the entries of the service's binding secret are projected into. When empty, the entries are merged with the entries of the primary service and must not conflict with entries of other services.
+func (d *ServiceBindingAdditionalServiceReferenceDie) Path(v string) *ServiceBindingAdditionalServiceReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceReference) {
+		r.Path = v
+	})
+}
+
+var EnvMappingBlank = (&EnvMappingDie{}).DieFeed(apisv1beta1.EnvMapping{})
+
+type EnvMappingDie struct {
+	mutable bool
+	r       apisv1beta1.EnvMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *EnvMappingDie) DieImmutable(immutable bool) *EnvMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *EnvMappingDie) DieFeed(r apisv1beta1.EnvMapping) *EnvMappingDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *EnvMappingDie) DieFeedPtr(r *apisv1beta1.EnvMapping) *EnvMappingDie {
+	if r == nil {
+		r = &apisv1beta1.EnvMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *EnvMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.EnvMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *EnvMappingDie) DieRelease() apisv1beta1.EnvMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *EnvMappingDie) DieReleasePtr() *apisv1beta1.EnvMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *EnvMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *EnvMappingDie) DieStamp(fn func(r *apisv1beta1.EnvMapping)) *EnvMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *EnvMappingDie) DeepCopy() *EnvMappingDie {
+	r := *d.r.DeepCopy()
+	return &EnvMappingDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name is the name of the environment variable
+func (d *EnvMappingDie) Name(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
+		r.Name = v
+	})
+}
+
+// Key is the key in the Secret that will be exposed. When the binding only has a ConfigMap, the key in the ConfigMap is exposed
+func (d *EnvMappingDie) Key(v string) *EnvMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.EnvMapping) {
+		r.Key = v
+	})
+}
+
+var ServiceBindingStatusBlank = (&ServiceBindingStatusDie{}).DieFeed(apisv1beta1.ServiceBindingStatus{})
+
+type ServiceBindingStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingStatusDie) DieImmutable(immutable bool) *ServiceBindingStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingStatusDie) DieFeed(r apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingStatus) *ServiceBindingStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingStatusDie) DieRelease() apisv1beta1.ServiceBindingStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingStatus)) *ServiceBindingStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingStatusDie) DeepCopy() *ServiceBindingStatusDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// ObservedGeneration is the 'Generation' of the ServiceBinding that was last processed by the controller.
+func (d *ServiceBindingStatusDie) ObservedGeneration(v int64) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.ObservedGeneration = v
+	})
+}
+
+// Conditions are the conditions of this ServiceBinding
+func (d *ServiceBindingStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Conditions = v
+	})
+}
+
+// Binding exposes the projected secret for this ServiceBinding
+func (d *ServiceBindingStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Binding = v
+	})
+}
+
+// Service is the service that provides the binding secret, either the referenced service, a service matching the selector or a fallback service
+func (d *ServiceBindingStatusDie) Service(v *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Service = v
+	})
+}
+
+// ReferenceGrant records the grant that permits the reference to the service in another namespace, and the binding secret copied from that namespace
+func (d *ServiceBindingStatusDie) ReferenceGrant(v *apisv1beta1.ServiceBindingReferenceGrantStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.ReferenceGrant = v
+	})
+}
+
+// ConfigMap exposes the projected config map for this ServiceBinding. The entries of the config map are projected alongside the entries of the binding secret
+func (d *ServiceBindingStatusDie) ConfigMap(v *apisv1beta1.ServiceBindingConfigMapReference) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.ConfigMap = v
+	})
+}
+
+// AdditionalServices reports the state of each additional service, in the same order as the spec
+func (d *ServiceBindingStatusDie) AdditionalServices(v ...apisv1beta1.ServiceBindingAdditionalServiceStatus) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.AdditionalServices = v
+	})
+}
+
+// Type is the type of the binding, from the spec when defined, otherwise from the `type` entry of the binding
+func (d *ServiceBindingStatusDie) Type(v string) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Type = v
+	})
+}
+
+// Provider is the provider of the binding, from the spec when defined, otherwise from the `provider` entry of the binding
+func (d *ServiceBindingStatusDie) Provider(v string) *ServiceBindingStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingStatus) {
+		r.Provider = v
+	})
+}
+
+var ServiceBindingAdditionalServiceStatusBlank = (&ServiceBindingAdditionalServiceStatusDie{}).DieFeed(apisv1beta1.ServiceBindingAdditionalServiceStatus{})
+
+type ServiceBindingAdditionalServiceStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingAdditionalServiceStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingAdditionalServiceStatusDie) DieImmutable(immutable bool) *ServiceBindingAdditionalServiceStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieFeed(r apisv1beta1.ServiceBindingAdditionalServiceStatus) *ServiceBindingAdditionalServiceStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingAdditionalServiceStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) *ServiceBindingAdditionalServiceStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingAdditionalServiceStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingAdditionalServiceStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingAdditionalServiceStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieRelease() apisv1beta1.ServiceBindingAdditionalServiceStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingAdditionalServiceStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingAdditionalServiceStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus)) *ServiceBindingAdditionalServiceStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingAdditionalServiceStatusDie) DeepCopy() *ServiceBindingAdditionalServiceStatusDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingAdditionalServiceStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Path is the subdirectory within the binding that the service is projected into
+func (d *ServiceBindingAdditionalServiceStatusDie) Path(v string) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Path = v
+	})
+}
+
+// Service is the service that provides the binding secret
+func (d *ServiceBindingAdditionalServiceStatusDie) Service(v *apisv1beta1.ServiceBindingServiceReference) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Service = v
+	})
+}
+
+// Binding exposes the projected secret of the service. Not defined when the secret is not projected.
+func (d *ServiceBindingAdditionalServiceStatusDie) Binding(v *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Binding = v
+	})
+}
+
+// Keys are the entries of the binding secret projected into the path. Only defined when the path is defined.
+func (d *ServiceBindingAdditionalServiceStatusDie) Keys(v ...string) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Keys = v
+	})
+}
+
+// Conditions are the conditions of the additional service
+func (d *ServiceBindingAdditionalServiceStatusDie) Conditions(v ...metav1.Condition) *ServiceBindingAdditionalServiceStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingAdditionalServiceStatus) {
+		r.Conditions = v
+	})
+}
+
+var ServiceBindingSecretReferenceBlank = (&ServiceBindingSecretReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingSecretReference{})
+
+type ServiceBindingSecretReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingSecretReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *ServiceBindingSecretReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingSecretReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingSecretReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingSecretReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindingSecretReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingSecretReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingSecretReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name of the referent secret. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
+		r.Name = v
+	})
+}
+
+var ServiceBindingConfigMapReferenceBlank = (&ServiceBindingConfigMapReferenceDie{}).DieFeed(apisv1beta1.ServiceBindingConfigMapReference{})
+
+type ServiceBindingConfigMapReferenceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingConfigMapReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingConfigMapReferenceDie) DieImmutable(immutable bool) *ServiceBindingConfigMapReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingConfigMapReferenceDie) DieFeed(r apisv1beta1.ServiceBindingConfigMapReference) *ServiceBindingConfigMapReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingConfigMapReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingConfigMapReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingConfigMapReference) *ServiceBindingConfigMapReferenceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingConfigMapReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingConfigMapReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingConfigMapReferenceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingConfigMapReference{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingConfigMapReferenceDie) DieRelease() apisv1beta1.ServiceBindingConfigMapReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingConfigMapReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingConfigMapReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingConfigMapReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingConfigMapReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingConfigMapReference)) *ServiceBindingConfigMapReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingConfigMapReferenceDie) DeepCopy() *ServiceBindingConfigMapReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingConfigMapReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Name of the referent config map. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+func (d *ServiceBindingConfigMapReferenceDie) Name(v string) *ServiceBindingConfigMapReferenceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingConfigMapReference) {
+		r.Name = v
+	})
+}
+
+var ServiceBindingReferenceGrantStatusBlank = (&ServiceBindingReferenceGrantStatusDie{}).DieFeed(apisv1beta1.ServiceBindingReferenceGrantStatus{})
+
+type ServiceBindingReferenceGrantStatusDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingReferenceGrantStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingReferenceGrantStatusDie) DieImmutable(immutable bool) *ServiceBindingReferenceGrantStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingReferenceGrantStatusDie) DieFeed(r apisv1beta1.ServiceBindingReferenceGrantStatus) *ServiceBindingReferenceGrantStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingReferenceGrantStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingReferenceGrantStatusDie) DieFeedPtr(r *apisv1beta1.ServiceBindingReferenceGrantStatus) *ServiceBindingReferenceGrantStatusDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingReferenceGrantStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingReferenceGrantStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingReferenceGrantStatusDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingReferenceGrantStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingReferenceGrantStatusDie) DieRelease() apisv1beta1.ServiceBindingReferenceGrantStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingReferenceGrantStatusDie) DieReleasePtr() *apisv1beta1.ServiceBindingReferenceGrantStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingReferenceGrantStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingReferenceGrantStatusDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingReferenceGrantStatus)) *ServiceBindingReferenceGrantStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingReferenceGrantStatusDie) DeepCopy() *ServiceBindingReferenceGrantStatusDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingReferenceGrantStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Namespace of the service and of the ReferenceGrant
+func (d *ServiceBindingReferenceGrantStatusDie) Namespace(v string) *ServiceBindingReferenceGrantStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingReferenceGrantStatus) {
+		r.Namespace = v
+	})
+}
+
+// Name of the ReferenceGrant
+func (d *ServiceBindingReferenceGrantStatusDie) Name(v string) *ServiceBindingReferenceGrantStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingReferenceGrantStatus) {
+		r.Name = v
+	})
+}
+
+// Secret is the name of the binding secret, in the namespace of the service, that is copied into the namespace of the ServiceBinding
+func (d *ServiceBindingReferenceGrantStatusDie) Secret(v string) *ServiceBindingReferenceGrantStatusDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingReferenceGrantStatus) {
+		r.Secret = v
+	})
+}
+
+var ServiceBindingPolicyBlank = (&ServiceBindingPolicyDie{}).DieFeed(apisv1beta1.ServiceBindingPolicy{})
+
+type ServiceBindingPolicyDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.ServiceBindingPolicy
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingPolicyDie) DieImmutable(immutable bool) *ServiceBindingPolicyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingPolicyDie) DieFeed(r apisv1beta1.ServiceBindingPolicy) *ServiceBindingPolicyDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ServiceBindingPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingPolicyDie) DieFeedPtr(r *apisv1beta1.ServiceBindingPolicy) *ServiceBindingPolicyDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingPolicy{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingPolicyDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingPolicy{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingPolicyDie) DieRelease() apisv1beta1.ServiceBindingPolicy {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingPolicyDie) DieReleasePtr() *apisv1beta1.ServiceBindingPolicy {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *ServiceBindingPolicyDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingPolicyDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingPolicy)) *ServiceBindingPolicyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingPolicyDie) DeepCopy() *ServiceBindingPolicyDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*ServiceBindingPolicyDie)(nil)
+
+func (d *ServiceBindingPolicyDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ServiceBindingPolicyDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ServiceBindingPolicyDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ServiceBindingPolicyDie) UnmarshalJSON(b []byte) error {
+	if d == ServiceBindingPolicyBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.ServiceBindingPolicy{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ServiceBindingPolicyDie) APIVersion(v string) *ServiceBindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicy) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingPolicyDie) Kind(v string) *ServiceBindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicy) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ServiceBindingPolicyDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ServiceBindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicy) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ServiceBindingPolicyDie) SpecDie(fn func(d *ServiceBindingPolicySpecDie)) *ServiceBindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicy) {
+		d := ServiceBindingPolicySpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ServiceBindingPolicyDie) Spec(v apisv1beta1.ServiceBindingPolicySpec) *ServiceBindingPolicyDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicy) {
+		r.Spec = v
+	})
+}
+
+var ServiceBindingPolicySpecBlank = (&ServiceBindingPolicySpecDie{}).DieFeed(apisv1beta1.ServiceBindingPolicySpec{})
+
+type ServiceBindingPolicySpecDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingPolicySpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingPolicySpecDie) DieImmutable(immutable bool) *ServiceBindingPolicySpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1261,35 +4430,35 @@ func (d *ServiceBindingSecretReferenceDie) DieImmutable(immutable bool) *Service
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceBindingSecretReferenceDie) DieFeed(r apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingPolicySpecDie) DieFeed(r apisv1beta1.ServiceBindingPolicySpec) *ServiceBindingPolicySpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingPolicySpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceBindingSecretReferenceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingSecretReference) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingPolicySpecDie) DieFeedPtr(r *apisv1beta1.ServiceBindingPolicySpec) *ServiceBindingPolicySpecDie {
 	if r == nil {
-		r = &apisv1beta1.ServiceBindingSecretReference{}
+		r = &apisv1beta1.ServiceBindingPolicySpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingPolicySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingPolicySpecDie {
 	b, _ := json.Marshal(raw)
-	r := apisv1beta1.ServiceBindingSecretReference{}
+	r := apisv1beta1.ServiceBindingPolicySpec{}
 	_ = json.Unmarshal(b, &r)
 	return d.DieFeed(r)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindingSecretReference {
+func (d *ServiceBindingPolicySpecDie) DieRelease() apisv1beta1.ServiceBindingPolicySpec {
 	if d.mutable {
 		return d.r
 	}
@@ -1297,13 +4466,13 @@ func (d *ServiceBindingSecretReferenceDie) DieRelease() apisv1beta1.ServiceBindi
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceBindingSecretReferenceDie) DieReleasePtr() *apisv1beta1.ServiceBindingSecretReference {
+func (d *ServiceBindingPolicySpecDie) DieReleasePtr() *apisv1beta1.ServiceBindingPolicySpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension.
-func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ServiceBindingPolicySpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	r := d.DieReleasePtr()
 	b, _ := json.Marshal(r)
 	raw := runtime.RawExtension{}
@@ -1312,24 +4481,313 @@ func (d *ServiceBindingSecretReferenceDie) DieReleaseRawExtension() runtime.RawE
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceBindingSecretReferenceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingSecretReference)) *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingPolicySpecDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingPolicySpec)) *ServiceBindingPolicySpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceBindingSecretReferenceDie) DeepCopy() *ServiceBindingSecretReferenceDie {
+func (d *ServiceBindingPolicySpecDie) DeepCopy() *ServiceBindingPolicySpecDie {
 	r := *d.r.DeepCopy()
-	return &ServiceBindingSecretReferenceDie{
+	return &ServiceBindingPolicySpecDie{
 		mutable: d.mutable,
 		r:       r,
 	}
 }
 
-// Name of the referent secret. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-func (d *ServiceBindingSecretReferenceDie) Name(v string) *ServiceBindingSecretReferenceDie {
-	return d.DieStamp(func(r *apisv1beta1.ServiceBindingSecretReference) {
-		r.Name = v
+// NamespaceSelector selects the namespaces whose ServiceBindings are subject to the policy. When empty, the policy applies to every namespace.
+func (d *ServiceBindingPolicySpecDie) NamespaceSelector(v *metav1.LabelSelector) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicySpec) {
+		r.NamespaceSelector = v
+	})
+}
+
+// Services are the kinds of services that may be bound, including fallback and additional services. When empty, every kind of service may be bound.
+func (d *ServiceBindingPolicySpecDie) Services(v ...apisv1beta1.ServiceBindingPolicyResource) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicySpec) {
+		r.Services = v
+	})
+}
+
+// Workloads are the kinds of workloads that may be bound. When empty, every kind of workload may be bound.
+func (d *ServiceBindingPolicySpecDie) Workloads(v ...apisv1beta1.ServiceBindingPolicyResource) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicySpec) {
+		r.Workloads = v
+	})
+}
+
+// Types are the values permitted for the type of a ServiceBinding. When set, ServiceBindings must set a permitted type, and the `type` entry of the binding, when present, must also be permitted. When empty, any type is permitted.
+func (d *ServiceBindingPolicySpecDie) Types(v ...string) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicySpec) {
+		r.Types = v
+	})
+}
+
+// DeniedContainers are the names of containers that may not be bound. When set, ServiceBindings must name the containers to bind, as every container of the workload is bound otherwise.
+func (d *ServiceBindingPolicySpecDie) DeniedContainers(v ...string) *ServiceBindingPolicySpecDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicySpec) {
+		r.DeniedContainers = v
+	})
+}
+
+var ServiceBindingPolicyResourceBlank = (&ServiceBindingPolicyResourceDie{}).DieFeed(apisv1beta1.ServiceBindingPolicyResource{})
+
+type ServiceBindingPolicyResourceDie struct {
+	mutable bool
+	r       apisv1beta1.ServiceBindingPolicyResource
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceBindingPolicyResourceDie) DieImmutable(immutable bool) *ServiceBindingPolicyResourceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceBindingPolicyResourceDie) DieFeed(r apisv1beta1.ServiceBindingPolicyResource) *ServiceBindingPolicyResourceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceBindingPolicyResourceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceBindingPolicyResourceDie) DieFeedPtr(r *apisv1beta1.ServiceBindingPolicyResource) *ServiceBindingPolicyResourceDie {
+	if r == nil {
+		r = &apisv1beta1.ServiceBindingPolicyResource{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingPolicyResourceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceBindingPolicyResourceDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.ServiceBindingPolicyResource{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceBindingPolicyResourceDie) DieRelease() apisv1beta1.ServiceBindingPolicyResource {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceBindingPolicyResourceDie) DieReleasePtr() *apisv1beta1.ServiceBindingPolicyResource {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *ServiceBindingPolicyResourceDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceBindingPolicyResourceDie) DieStamp(fn func(r *apisv1beta1.ServiceBindingPolicyResource)) *ServiceBindingPolicyResourceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceBindingPolicyResourceDie) DeepCopy() *ServiceBindingPolicyResourceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceBindingPolicyResourceDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// Group of the resource, empty for the core API group.
+func (d *ServiceBindingPolicyResourceDie) Group(v string) *ServiceBindingPolicyResourceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicyResource) {
+		r.Group = v
+	})
+}
+
+// Kind of the resource, `*` permits every kind in the group. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ServiceBindingPolicyResourceDie) Kind(v string) *ServiceBindingPolicyResourceDie {
+	return d.DieStamp(func(r *apisv1beta1.ServiceBindingPolicyResource) {
+		r.Kind = v
+	})
+}
+
+var WorkloadResourceMappingBlank = (&WorkloadResourceMappingDie{}).DieFeed(apisv1beta1.WorkloadResourceMapping{})
+
+type WorkloadResourceMappingDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       apisv1beta1.WorkloadResourceMapping
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *WorkloadResourceMappingDie) DieImmutable(immutable bool) *WorkloadResourceMappingDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *WorkloadResourceMappingDie) DieFeed(r apisv1beta1.WorkloadResourceMapping) *WorkloadResourceMappingDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &WorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *WorkloadResourceMappingDie) DieFeedPtr(r *apisv1beta1.WorkloadResourceMapping) *WorkloadResourceMappingDie {
+	if r == nil {
+		r = &apisv1beta1.WorkloadResourceMapping{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *WorkloadResourceMappingDie) DieFeedRawExtension(raw runtime.RawExtension) *WorkloadResourceMappingDie {
+	b, _ := json.Marshal(raw)
+	r := apisv1beta1.WorkloadResourceMapping{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *WorkloadResourceMappingDie) DieRelease() apisv1beta1.WorkloadResourceMapping {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *WorkloadResourceMappingDie) DieReleasePtr() *apisv1beta1.WorkloadResourceMapping {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *WorkloadResourceMappingDie) DieReleaseUnstructured() runtime.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *WorkloadResourceMappingDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *WorkloadResourceMappingDie) DieStamp(fn func(r *apisv1beta1.WorkloadResourceMapping)) *WorkloadResourceMappingDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *WorkloadResourceMappingDie) DeepCopy() *WorkloadResourceMappingDie {
+	r := *d.r.DeepCopy()
+	return &WorkloadResourceMappingDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*WorkloadResourceMappingDie)(nil)
+
+func (d *WorkloadResourceMappingDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *WorkloadResourceMappingDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *WorkloadResourceMappingDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *WorkloadResourceMappingDie) UnmarshalJSON(b []byte) error {
+	if d == WorkloadResourceMappingBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &apisv1beta1.WorkloadResourceMapping{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *WorkloadResourceMappingDie) APIVersion(v string) *WorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.WorkloadResourceMapping) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *WorkloadResourceMappingDie) Kind(v string) *WorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.WorkloadResourceMapping) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *WorkloadResourceMappingDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *WorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.WorkloadResourceMapping) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *WorkloadResourceMappingDie) SpecDie(fn func(d *ClusterWorkloadResourceMappingSpecDie)) *WorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.WorkloadResourceMapping) {
+		d := ClusterWorkloadResourceMappingSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *WorkloadResourceMappingDie) Spec(v apisv1beta1.ClusterWorkloadResourceMappingSpec) *WorkloadResourceMappingDie {
+	return d.DieStamp(func(r *apisv1beta1.WorkloadResourceMapping) {
+		r.Spec = v
 	})
 }
@@ -27,6 +27,123 @@ import (
 	testing "dies.dev/testing"
 )
 
+func TestBindingPolicyDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicySpecDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicySpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicySpecDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicyWorkloadSelectorDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyWorkloadSelectorBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyWorkloadSelectorDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicyBindingDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyBindingBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyBindingDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicyStatusDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyStatusDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicyServiceBindingStatusDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyServiceBindingStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyServiceBindingStatusDie: %s", diff.List())
+	}
+}
+
+func TestBindingPolicyWorkloadStatusDie_MissingMethods(t *testingx.T) {
+	die := BindingPolicyWorkloadStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingPolicyWorkloadStatusDie: %s", diff.List())
+	}
+}
+
+func TestBindingTypeSchemaDie_MissingMethods(t *testingx.T) {
+	die := BindingTypeSchemaBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingTypeSchemaDie: %s", diff.List())
+	}
+}
+
+func TestBindingTypeSchemaSpecDie_MissingMethods(t *testingx.T) {
+	die := BindingTypeSchemaSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for BindingTypeSchemaSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingSpecDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingSpecDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingTemplateDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingTemplateBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingTemplateDie: %s", diff.List())
+	}
+}
+
+func TestClusterServiceResourceMappingDataDie_MissingMethods(t *testingx.T) {
+	die := ClusterServiceResourceMappingDataBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterServiceResourceMappingDataDie: %s", diff.List())
+	}
+}
+
 func TestClusterWorkloadResourceMappingDie_MissingMethods(t *testingx.T) {
 	die := ClusterWorkloadResourceMappingBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -54,6 +171,15 @@ func TestClusterWorkloadResourceMappingTemplateDie_MissingMethods(t *testingx.T)
 	}
 }
 
+func TestClusterWorkloadResourceMappingPodTemplateDie_MissingMethods(t *testingx.T) {
+	die := ClusterWorkloadResourceMappingPodTemplateBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterWorkloadResourceMappingPodTemplateDie: %s", diff.List())
+	}
+}
+
 func TestClusterWorkloadResourceMappingContainerDie_MissingMethods(t *testingx.T) {
 	die := ClusterWorkloadResourceMappingContainerBlank
 	ignore := []string{}
@@ -63,6 +189,60 @@ func TestClusterWorkloadResourceMappingContainerDie_MissingMethods(t *testingx.T
 	}
 }
 
+func TestClusterWorkloadResourceMappingStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterWorkloadResourceMappingStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterWorkloadResourceMappingStatusDie: %s", diff.List())
+	}
+}
+
+func TestClusterWorkloadResourceMappingVersionStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterWorkloadResourceMappingVersionStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterWorkloadResourceMappingVersionStatusDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantSpecDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantSpecDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantFromDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantFromBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantFromDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantToDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantToBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantToDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -99,6 +279,15 @@ func TestServiceBindingServiceReferenceDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingAdditionalServiceReferenceDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingAdditionalServiceReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingAdditionalServiceReferenceDie: %s", diff.List())
+	}
+}
+
 func TestEnvMappingDie_MissingMethods(t *testingx.T) {
 	die := EnvMappingBlank
 	ignore := []string{}
@@ -117,6 +306,15 @@ func TestServiceBindingStatusDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestServiceBindingAdditionalServiceStatusDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingAdditionalServiceStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingAdditionalServiceStatusDie: %s", diff.List())
+	}
+}
+
 func TestServiceBindingSecretReferenceDie_MissingMethods(t *testingx.T) {
 	die := ServiceBindingSecretReferenceBlank
 	ignore := []string{}
@@ -125,3 +323,57 @@ func TestServiceBindingSecretReferenceDie_MissingMethods(t *testingx.T) {
 		t.Errorf("found missing fields for ServiceBindingSecretReferenceDie: %s", diff.List())
 	}
 }
+
+func TestServiceBindingConfigMapReferenceDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingConfigMapReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingConfigMapReferenceDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingReferenceGrantStatusDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingReferenceGrantStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingReferenceGrantStatusDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingPolicyDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingPolicyBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingPolicyDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingPolicySpecDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingPolicySpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingPolicySpecDie: %s", diff.List())
+	}
+}
+
+func TestServiceBindingPolicyResourceDie_MissingMethods(t *testingx.T) {
+	die := ServiceBindingPolicyResourceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ServiceBindingPolicyResourceDie: %s", diff.List())
+	}
+}
+
+func TestWorkloadResourceMappingDie_MissingMethods(t *testingx.T) {
+	die := WorkloadResourceMappingBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for WorkloadResourceMappingDie: %s", diff.List())
+	}
+}
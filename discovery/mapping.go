@@ -0,0 +1,232 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+const (
+	// DiscoverLabel opts a CustomResourceDefinition into having a ClusterWorkloadResourceMapping discovered and
+	// created for it, the value must be "true".
+	DiscoverLabel = "servicebinding.io/discover-mapping"
+)
+
+// ErrNoPodSpec is returned when a CustomResourceDefinition does not contain a PodSpec-like structure that can be mapped.
+var ErrNoPodSpec = errors.New("no PodSpec-like structure found")
+
+// Candidate is a ClusterWorkloadResourceMapping discovered for a CustomResourceDefinition. Notes describe pod-like
+// structures that were found within the schema, but could not be mapped.
+type Candidate struct {
+	Mapping *servicebindingv1beta1.ClusterWorkloadResourceMapping
+	Notes   []string
+}
+
+// MappingForCRD discovers a candidate ClusterWorkloadResourceMapping for the CustomResourceDefinition by walking the
+// structural schema of each served version, looking for embedded PodTemplateSpec, PodSpec and container-like
+// structures. When every version maps to the same locations, a single wildcard version is returned.
+func MappingForCRD(crd *apiextensionsv1.CustomResourceDefinition) (*Candidate, error) {
+	candidate := &Candidate{
+		Mapping: &servicebindingv1beta1.ClusterWorkloadResourceMapping{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: servicebindingv1beta1.GroupVersion.String(),
+				Kind:       "ClusterWorkloadResourceMapping",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s.%s", crd.Spec.Names.Plural, crd.Spec.Group),
+			},
+		},
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			candidate.Notes = append(candidate.Notes, fmt.Sprintf("version %s does not define a schema", version.Name))
+			continue
+		}
+		template, notes := MappingTemplateForSchema(version.Name, version.Schema.OpenAPIV3Schema)
+		for _, note := range notes {
+			candidate.Notes = append(candidate.Notes, fmt.Sprintf("version %s: %s", version.Name, note))
+		}
+		if template != nil {
+			candidate.Mapping.Spec.Versions = append(candidate.Mapping.Spec.Versions, *template)
+		}
+	}
+	if len(candidate.Mapping.Spec.Versions) == 0 {
+		return candidate, fmt.Errorf("%w in %s", ErrNoPodSpec, crd.Name)
+	}
+
+	// collapse identical versions into a wildcard
+	wildcard := candidate.Mapping.Spec.Versions[0].DeepCopy()
+	wildcard.Version = "*"
+	for _, template := range candidate.Mapping.Spec.Versions[1:] {
+		template.Version = "*"
+		if !equality.Semantic.DeepEqual(wildcard, &template) {
+			return candidate, nil
+		}
+	}
+	candidate.Mapping.Spec.Versions = []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{*wildcard}
+
+	return candidate, nil
+}
+
+// MappingTemplateForSchema discovers the mapping template for a single version of a resource. A nil template is
+// returned if no PodSpec-like structure is found. Notes describe pod-like structures that could not be mapped.
+func MappingTemplateForSchema(version string, schema *apiextensionsv1.JSONSchemaProps) (*servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, []string) {
+	w := &walker{}
+	w.walk(schema, "", false)
+
+	if len(w.podSpecs) == 0 {
+		return nil, w.notes
+	}
+	template := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Version: version,
+	}
+	if len(w.podSpecs) == 1 {
+		ps := w.podSpecs[0]
+		template.Annotations = ps.Annotations
+		template.Labels = ps.Labels
+		template.Containers = ps.Containers
+		template.Volumes = ps.Volumes
+		template.ImagePullSecrets = ps.ImagePullSecrets
+		return template, w.notes
+	}
+	template.PodTemplates = w.podSpecs
+	return template, w.notes
+}
+
+type walker struct {
+	podSpecs []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate
+	notes    []string
+}
+
+func (w *walker) walk(schema *apiextensionsv1.JSONSchemaProps, path string, inArray bool) {
+	if schema == nil {
+		return
+	}
+
+	switch {
+	case isPodTemplateSpec(schema):
+		name := path
+		if name == "" {
+			// the resource itself is pod-like
+			name = ".spec"
+		}
+		spec := schema.Properties["spec"]
+		w.podSpec(&spec, path+".spec", path+".metadata", name, inArray)
+		return
+	case isPodSpec(schema):
+		w.podSpec(schema, path, "", path, inArray)
+		return
+	case isContainer(schema):
+		w.notes = append(w.notes, fmt.Sprintf("container-like structure at %s is not within a PodSpec", path))
+		return
+	}
+
+	for _, name := range sortedProperties(schema) {
+		if path == "" && (name == "metadata" || name == "status") {
+			// only the spec of a resource is projected into
+			continue
+		}
+		property := schema.Properties[name]
+		w.walk(&property, fmt.Sprintf("%s.%s", path, name), inArray)
+	}
+	if schema.Items != nil {
+		w.walk(schema.Items.Schema, path+"[*]", true)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		w.walk(schema.AdditionalProperties.Schema, path+".*", true)
+	}
+}
+
+func (w *walker) podSpec(schema *apiextensionsv1.JSONSchemaProps, path, metadata, name string, inArray bool) {
+	if inArray {
+		w.notes = append(w.notes, fmt.Sprintf("PodSpec at %s is within a collection and cannot be mapped", path))
+		return
+	}
+	if metadata == "" {
+		w.notes = append(w.notes, fmt.Sprintf("PodSpec at %s does not have metadata for annotations and cannot be mapped", path))
+		return
+	}
+	podTemplate := servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+		Name:        strings.TrimPrefix(name, "."),
+		Annotations: metadata + ".annotations",
+		Labels:      metadata + ".labels",
+		Volumes:     path + ".volumes",
+	}
+	for _, collection := range []string{"initContainers", "containers"} {
+		if property, ok := schema.Properties[collection]; ok && property.Items != nil && isContainer(property.Items.Schema) {
+			podTemplate.Containers = append(podTemplate.Containers, servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+				Path: fmt.Sprintf("%s.%s[*]", path, collection),
+				Name: ".name",
+			})
+		}
+	}
+	if _, ok := schema.Properties["volumes"]; !ok {
+		w.notes = append(w.notes, fmt.Sprintf("PodSpec at %s does not define volumes", path))
+	}
+	if _, ok := schema.Properties["imagePullSecrets"]; ok {
+		podTemplate.ImagePullSecrets = path + ".imagePullSecrets"
+	}
+	w.podSpecs = append(w.podSpecs, podTemplate)
+}
+
+// isPodTemplateSpec matches objects with a PodSpec-like spec
+func isPodTemplateSpec(schema *apiextensionsv1.JSONSchemaProps) bool {
+	spec, ok := schema.Properties["spec"]
+	return ok && isPodSpec(&spec)
+}
+
+// isPodSpec matches objects with a collection of container-like containers
+func isPodSpec(schema *apiextensionsv1.JSONSchemaProps) bool {
+	containers, ok := schema.Properties["containers"]
+	return ok && containers.Type == "array" && containers.Items != nil && isContainer(containers.Items.Schema)
+}
+
+// isContainer matches objects with an env collection and either an image or volume mounts
+func isContainer(schema *apiextensionsv1.JSONSchemaProps) bool {
+	if schema == nil || schema.Type != "object" {
+		return false
+	}
+	env, ok := schema.Properties["env"]
+	if !ok || env.Type != "array" {
+		return false
+	}
+	_, hasImage := schema.Properties["image"]
+	volumeMounts, hasVolumeMounts := schema.Properties["volumeMounts"]
+	return hasImage || (hasVolumeMounts && volumeMounts.Type == "array")
+}
+
+func sortedProperties(schema *apiextensionsv1.JSONSchemaProps) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
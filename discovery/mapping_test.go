@@ -0,0 +1,331 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+func object(properties map[string]apiextensionsv1.JSONSchemaProps) apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		Type:       "object",
+		Properties: properties,
+	}
+}
+
+func array(items apiextensionsv1.JSONSchemaProps) apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		Type: "array",
+		Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+			Schema: &items,
+		},
+	}
+}
+
+func container() apiextensionsv1.JSONSchemaProps {
+	return object(map[string]apiextensionsv1.JSONSchemaProps{
+		"name":         {Type: "string"},
+		"image":        {Type: "string"},
+		"env":          array(object(nil)),
+		"envFrom":      array(object(nil)),
+		"volumeMounts": array(object(nil)),
+	})
+}
+
+func stringMap() apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+			Allows: true,
+			Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+		},
+	}
+}
+
+func podSpec() apiextensionsv1.JSONSchemaProps {
+	return object(map[string]apiextensionsv1.JSONSchemaProps{
+		"initContainers":   array(container()),
+		"containers":       array(container()),
+		"volumes":          array(object(nil)),
+		"imagePullSecrets": array(object(nil)),
+	})
+}
+
+func podTemplateSpec() apiextensionsv1.JSONSchemaProps {
+	return object(map[string]apiextensionsv1.JSONSchemaProps{
+		"metadata": object(map[string]apiextensionsv1.JSONSchemaProps{
+			"annotations": stringMap(),
+			"labels":      stringMap(),
+		}),
+		"spec": podSpec(),
+	})
+}
+
+func resource(spec apiextensionsv1.JSONSchemaProps) *apiextensionsv1.JSONSchemaProps {
+	schema := object(map[string]apiextensionsv1.JSONSchemaProps{
+		"apiVersion": {Type: "string"},
+		"kind":       {Type: "string"},
+		"metadata":   {Type: "object"},
+		"spec":       spec,
+		"status":     object(nil),
+	})
+	return &schema
+}
+
+func crd(versions ...apiextensionsv1.CustomResourceDefinitionVersion) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "workloads.example.com",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural: "workloads",
+				Kind:   "Workload",
+			},
+			Versions: versions,
+		},
+	}
+}
+
+func version(name string, schema *apiextensionsv1.JSONSchemaProps) apiextensionsv1.CustomResourceDefinitionVersion {
+	return apiextensionsv1.CustomResourceDefinitionVersion{
+		Name:   name,
+		Served: true,
+		Schema: &apiextensionsv1.CustomResourceValidation{
+			OpenAPIV3Schema: schema,
+		},
+	}
+}
+
+func TestMappingForCRD(t *testing.T) {
+	mapping := func(versions ...servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) *servicebindingv1beta1.ClusterWorkloadResourceMapping {
+		return &servicebindingv1beta1.ClusterWorkloadResourceMapping{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "servicebinding.io/v1beta1",
+				Kind:       "ClusterWorkloadResourceMapping",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "workloads.example.com",
+			},
+			Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+				Versions: versions,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		crd           *apiextensionsv1.CustomResourceDefinition
+		expected      *servicebindingv1beta1.ClusterWorkloadResourceMapping
+		expectedNotes []string
+		expectedErr   error
+	}{
+		{
+			name: "podspecable",
+			crd: crd(version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"replicas": {Type: "integer"},
+				"template": podTemplateSpec(),
+			})))),
+			expected: mapping(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.template.metadata.annotations",
+				Labels:      ".spec.template.metadata.labels",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.template.spec.initContainers[*]", Name: ".name"},
+					{Path: ".spec.template.spec.containers[*]", Name: ".name"},
+				},
+				Volumes:          ".spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
+			}),
+		},
+		{
+			name: "cronjob-like",
+			crd: crd(version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"schedule": {Type: "string"},
+				"jobTemplate": object(map[string]apiextensionsv1.JSONSchemaProps{
+					"spec": object(map[string]apiextensionsv1.JSONSchemaProps{
+						"template": podTemplateSpec(),
+					}),
+				}),
+			})))),
+			expected: mapping(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+				Labels:      ".spec.jobTemplate.spec.template.metadata.labels",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.jobTemplate.spec.template.spec.initContainers[*]", Name: ".name"},
+					{Path: ".spec.jobTemplate.spec.template.spec.containers[*]", Name: ".name"},
+				},
+				Volumes:          ".spec.jobTemplate.spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.jobTemplate.spec.template.spec.imagePullSecrets",
+			}),
+		},
+		{
+			name: "pod-like",
+			crd:  crd(version("v1", resource(podSpec()))),
+			expected: mapping(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".metadata.annotations",
+				Labels:      ".metadata.labels",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.initContainers[*]", Name: ".name"},
+					{Path: ".spec.containers[*]", Name: ".name"},
+				},
+				Volumes:          ".spec.volumes",
+				ImagePullSecrets: ".spec.imagePullSecrets",
+			}),
+		},
+		{
+			name: "multiple pod templates",
+			crd: crd(version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"driver": object(map[string]apiextensionsv1.JSONSchemaProps{
+					"template": podTemplateSpec(),
+				}),
+				"executor": object(map[string]apiextensionsv1.JSONSchemaProps{
+					"template": podTemplateSpec(),
+				}),
+			})))),
+			expected: mapping(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version: "*",
+				PodTemplates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Name:        "spec.driver.template",
+						Annotations: ".spec.driver.template.metadata.annotations",
+						Labels:      ".spec.driver.template.metadata.labels",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{Path: ".spec.driver.template.spec.initContainers[*]", Name: ".name"},
+							{Path: ".spec.driver.template.spec.containers[*]", Name: ".name"},
+						},
+						Volumes:          ".spec.driver.template.spec.volumes",
+						ImagePullSecrets: ".spec.driver.template.spec.imagePullSecrets",
+					},
+					{
+						Name:        "spec.executor.template",
+						Annotations: ".spec.executor.template.metadata.annotations",
+						Labels:      ".spec.executor.template.metadata.labels",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{Path: ".spec.executor.template.spec.initContainers[*]", Name: ".name"},
+							{Path: ".spec.executor.template.spec.containers[*]", Name: ".name"},
+						},
+						Volumes:          ".spec.executor.template.spec.volumes",
+						ImagePullSecrets: ".spec.executor.template.spec.imagePullSecrets",
+					},
+				},
+			}),
+		},
+		{
+			name: "distinct versions",
+			crd: crd(
+				version("v1alpha1", resource(podSpec())),
+				version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+					"template": podTemplateSpec(),
+				}))),
+			),
+			expected: mapping(
+				servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+					Version:     "v1alpha1",
+					Annotations: ".metadata.annotations",
+					Labels:      ".metadata.labels",
+					Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+						{Path: ".spec.initContainers[*]", Name: ".name"},
+						{Path: ".spec.containers[*]", Name: ".name"},
+					},
+					Volumes:          ".spec.volumes",
+					ImagePullSecrets: ".spec.imagePullSecrets",
+				},
+				servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+					Version:     "v1",
+					Annotations: ".spec.template.metadata.annotations",
+					Labels:      ".spec.template.metadata.labels",
+					Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+						{Path: ".spec.template.spec.initContainers[*]", Name: ".name"},
+						{Path: ".spec.template.spec.containers[*]", Name: ".name"},
+					},
+					Volumes:          ".spec.template.spec.volumes",
+					ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
+				},
+			),
+		},
+		{
+			name: "pod templates in a collection",
+			crd: crd(version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"template": podTemplateSpec(),
+				"replicaSpecs": array(object(map[string]apiextensionsv1.JSONSchemaProps{
+					"template": podTemplateSpec(),
+				})),
+			})))),
+			expected: mapping(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.template.metadata.annotations",
+				Labels:      ".spec.template.metadata.labels",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.template.spec.initContainers[*]", Name: ".name"},
+					{Path: ".spec.template.spec.containers[*]", Name: ".name"},
+				},
+				Volumes:          ".spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
+			}),
+			expectedNotes: []string{
+				"version v1: PodSpec at .spec.replicaSpecs[*].template.spec is within a collection and cannot be mapped",
+			},
+		},
+		{
+			name: "no pod spec",
+			crd: crd(version("v1", resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"sidecar": container(),
+			})))),
+			expectedNotes: []string{
+				"version v1: container-like structure at .spec.sidecar is not within a PodSpec",
+			},
+			expectedErr: ErrNoPodSpec,
+		},
+		{
+			name:        "no schema",
+			crd:         crd(apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true}),
+			expectedErr: ErrNoPodSpec,
+			expectedNotes: []string{
+				"version v1 does not define a schema",
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			candidate, err := MappingForCRD(c.crd)
+
+			if (err != nil) != (c.expectedErr != nil) || (c.expectedErr != nil && !errors.Is(err, c.expectedErr)) {
+				t.Fatalf("MappingForCRD() expected err: %v, actual err: %v", c.expectedErr, err)
+			}
+			if c.expectedErr == nil {
+				if diff := cmp.Diff(c.expected, candidate.Mapping); diff != "" {
+					t.Errorf("MappingForCRD() (-expected, +actual): %s", diff)
+				}
+			}
+			if diff := cmp.Diff(c.expectedNotes, candidate.Notes); diff != "" {
+				t.Errorf("MappingForCRD() notes (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
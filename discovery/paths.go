@@ -0,0 +1,152 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/util/jsonpath"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// UnresolvedPaths returns the paths of the mapping template that do not exist within the structural schema of a
+// resource. Values written to an unresolved location are likely to be pruned by the API server. Paths that traverse a
+// schema preserving unknown fields, or the object metadata, are considered resolved. Container paths are reported
+// joined with the container's relative path.
+func UnresolvedPaths(template *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, schema *apiextensionsv1.JSONSchemaProps) []string {
+	unresolved := []string{}
+
+	if len(template.PodTemplates) != 0 {
+		for i := range template.PodTemplates {
+			podTemplate := template.PodTemplates[i]
+			unresolved = append(unresolved, unresolvedPodTemplatePaths(podTemplate.Annotations, podTemplate.Labels, podTemplate.Volumes, podTemplate.ImagePullSecrets, podTemplate.Containers, schema)...)
+		}
+		return unresolved
+	}
+	return unresolvedPodTemplatePaths(template.Annotations, template.Labels, template.Volumes, template.ImagePullSecrets, template.Containers, schema)
+}
+
+func unresolvedPodTemplatePaths(annotations, labels, volumes, imagePullSecrets string, containers []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer, schema *apiextensionsv1.JSONSchemaProps) []string {
+	unresolved := []string{}
+
+	for _, path := range []string{annotations, labels, volumes, imagePullSecrets} {
+		if path == "" {
+			continue
+		}
+		if _, ok := resolvePath(schema, path, true); !ok {
+			unresolved = append(unresolved, path)
+		}
+	}
+	for _, container := range containers {
+		schemas, ok := resolvePath(schema, container.Path, true)
+		if !ok {
+			unresolved = append(unresolved, container.Path)
+			continue
+		}
+		for _, path := range []string{container.Name, container.Env, container.VolumeMounts, container.EnvFrom} {
+			if path == "" {
+				continue
+			}
+			for _, s := range schemas {
+				if _, ok := resolvePath(s, path, false); !ok {
+					unresolved = append(unresolved, container.Path+path)
+					break
+				}
+			}
+		}
+	}
+
+	return unresolved
+}
+
+// resolvePath returns the schemas matched by the path. Schemas are not returned for locations whose content is not
+// constrained, the path is still considered resolved.
+func resolvePath(schema *apiextensionsv1.JSONSchemaProps, path string, root bool) ([]*apiextensionsv1.JSONSchemaProps, bool) {
+	p, err := jsonpath.Parse("", fmt.Sprintf("{%s}", path))
+	if err != nil || len(p.Root.Nodes) != 1 {
+		return nil, false
+	}
+	return resolveNodes([]*apiextensionsv1.JSONSchemaProps{schema}, flattenJsonPath(p.Root), root)
+}
+
+func resolveNodes(schemas []*apiextensionsv1.JSONSchemaProps, nodes []jsonpath.Node, root bool) ([]*apiextensionsv1.JSONSchemaProps, bool) {
+	for i, node := range nodes {
+		next := []*apiextensionsv1.JSONSchemaProps{}
+		union := false
+		for _, schema := range schemas {
+			if schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields {
+				// unknown fields are not pruned
+				continue
+			}
+			switch n := node.(type) {
+			case *jsonpath.FieldNode:
+				if (root || schema.XEmbeddedResource) && n.Value == "metadata" {
+					// object metadata is defined by the API server
+					continue
+				}
+				if property, ok := schema.Properties[n.Value]; ok {
+					next = append(next, &property)
+				} else if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+					next = append(next, schema.AdditionalProperties.Schema)
+				} else if schema.AdditionalProperties == nil || !schema.AdditionalProperties.Allows {
+					return nil, false
+				}
+			case *jsonpath.ArrayNode, *jsonpath.FilterNode, *jsonpath.WildcardNode:
+				if schema.Items != nil && schema.Items.Schema != nil {
+					next = append(next, schema.Items.Schema)
+				} else if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+					// map-valued collection
+					next = append(next, schema.AdditionalProperties.Schema)
+				} else {
+					return nil, false
+				}
+			case *jsonpath.UnionNode:
+				// each branch resolves the remainder of the path
+				union = true
+				for _, list := range n.Nodes {
+					s, ok := resolveNodes([]*apiextensionsv1.JSONSchemaProps{schema}, append(flattenJsonPath(list), nodes[i+1:]...), root)
+					if !ok {
+						return nil, false
+					}
+					next = append(next, s...)
+				}
+			default:
+				// recursive descent and other nodes can not be resolved statically
+				continue
+			}
+		}
+		if union {
+			return next, true
+		}
+		schemas = next
+		root = false
+	}
+	return schemas, true
+}
+
+func flattenJsonPath(node jsonpath.Node) []jsonpath.Node {
+	if list, ok := node.(*jsonpath.ListNode); ok {
+		nodes := []jsonpath.Node{}
+		for i := range list.Nodes {
+			nodes = append(nodes, flattenJsonPath(list.Nodes[i])...)
+		}
+		return nodes
+	}
+	return []jsonpath.Node{node}
+}
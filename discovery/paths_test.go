@@ -0,0 +1,177 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/pointer"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+func TestUnresolvedPaths(t *testing.T) {
+	podSpecable := resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+		"template": podTemplateSpec(),
+	}))
+	defaulted := func() *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate {
+		template := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+			Version: "*",
+		}
+		template.Default()
+		return template
+	}
+
+	tests := []struct {
+		name     string
+		template *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+		schema   *apiextensionsv1.JSONSchemaProps
+		expected []string
+	}{
+		{
+			name:     "podspecable",
+			template: defaulted(),
+			schema:   podSpecable,
+			expected: []string{},
+		},
+		{
+			name:     "missing locations",
+			template: defaulted(),
+			schema: resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"template": object(map[string]apiextensionsv1.JSONSchemaProps{
+					"metadata": {Type: "object"},
+					"spec": object(map[string]apiextensionsv1.JSONSchemaProps{
+						"containers": array(object(map[string]apiextensionsv1.JSONSchemaProps{
+							"name":  {Type: "string"},
+							"image": {Type: "string"},
+						})),
+					}),
+				}),
+			})),
+			expected: []string{
+				".spec.template.metadata.annotations",
+				".spec.template.metadata.labels",
+				".spec.template.spec.volumes",
+				".spec.template.spec.imagePullSecrets",
+				".spec.template.spec.initContainers[*]",
+				".spec.template.spec.containers[*].env",
+				".spec.template.spec.containers[*].volumeMounts",
+				".spec.template.spec.containers[*].envFrom",
+			},
+		},
+		{
+			name: "resource metadata",
+			template: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.containers[*]", Env: ".env"},
+				},
+				Volumes: ".spec.volumes",
+			},
+			schema:   resource(podSpec()),
+			expected: []string{},
+		},
+		{
+			name: "preserve unknown fields",
+			template: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.template.spec.containers[*]", Env: ".env"},
+				},
+				Volumes: ".spec.template.spec.volumes",
+			},
+			schema: resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"template": {
+					Type:                   "object",
+					XPreserveUnknownFields: pointer.Bool(true),
+				},
+			})),
+			expected: []string{},
+		},
+		{
+			name: "pod templates",
+			template: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version: "*",
+				PodTemplates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+					{
+						Name:        "driver",
+						Annotations: ".spec.driver.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{Path: ".spec.driver.spec.containers[*]", Env: ".env"},
+						},
+						Volumes: ".spec.driver.spec.volumes",
+					},
+					{
+						Name:        "executor",
+						Annotations: ".spec.executor.metadata.annotations",
+						Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+							{Path: ".spec.executor.spec.containers[*]", Env: ".env"},
+						},
+						Volumes: ".spec.executor.spec.volumes",
+					},
+				},
+			},
+			schema: resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"driver": podTemplateSpec(),
+			})),
+			expected: []string{
+				".spec.executor.metadata.annotations",
+				".spec.executor.spec.volumes",
+				".spec.executor.spec.containers[*]",
+			},
+		},
+		{
+			name: "map-valued collection and union",
+			template: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{Path: ".spec.template.spec['initContainers','containers'][*]", Env: ".env"},
+					{Path: ".spec.sidecars.*", Env: ".env"},
+				},
+				Volumes: ".spec.template.spec.volumes",
+			},
+			schema: resource(object(map[string]apiextensionsv1.JSONSchemaProps{
+				"template": podTemplateSpec(),
+				"sidecars": {
+					Type: "object",
+					AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+						Allows: true,
+						Schema: func() *apiextensionsv1.JSONSchemaProps {
+							c := container()
+							return &c
+						}(),
+					},
+				},
+			})),
+			expected: []string{},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := UnresolvedPaths(c.template, c.schema)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("UnresolvedPaths() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
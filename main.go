@@ -19,6 +19,7 @@ package main
 import (
 	"flag"
 	"os"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -26,15 +27,26 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 	"github.com/scothis/servicebinding-runtime/controllers"
+	mappingdiscovery "github.com/scothis/servicebinding-runtime/discovery"
+	"github.com/scothis/servicebinding-runtime/projector"
 	"github.com/scothis/servicebinding-runtime/rbac"
 	//+kubebuilder:scaffold:imports
 )
@@ -47,6 +59,7 @@ var (
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 
 	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
@@ -56,11 +69,31 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var workloadBindingsKinds string
+	var enforceAuthorAccess bool
+	var typeProjections string
+	var enableMappingDiscovery bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&workloadBindingsKinds, "workload-bindings-kinds", "",
+		"Comma separated list of workload kinds, formatted as Kind.version.group, whose bindings declared by the "+
+			servicebindingv1beta1.WorkloadBindingsAnnotation+" annotation are reconciled. "+
+			"Use Kind.version. for the core group. Disabled when empty.")
+	flag.BoolVar(&enforceAuthorAccess, "enforce-author-access", false,
+		"Only project a ServiceBinding when the user who authored it is permitted to get the services and binding "+
+			"secrets, and to update the workloads. ServiceBindings without a recorded author are not projected. "+
+			"The author of a BindingPolicy is checked for the ServiceBindings generated for the policy, the "+
+			"ServiceBindings generated for the bindings annotation of a workload are not projected.")
+	flag.StringVar(&typeProjections, "type-projections", projector.DockerRegistryType+"=imagePullSecrets",
+		"Semicolon separated list of binding types and the optional projections applied to bindings of that type, "+
+			"formatted as type=projection where projection is a comma separated list of labels, envFrom and "+
+			"imagePullSecrets. For example \"docker-registry=imagePullSecrets;mysql=labels,envFrom\".")
+	flag.BoolVar(&enableMappingDiscovery, "enable-mapping-discovery", false,
+		"Create a ClusterWorkloadResourceMapping for each CustomResourceDefinition that opts in with the "+
+			"label "+mappingdiscovery.DiscoverLabel+"=true.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -69,6 +102,12 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	bindingTypeProjections, err := projector.ParseTypeProjections(typeProjections)
+	if err != nil {
+		setupLog.Error(err, "invalid type projections", "type-projections", typeProjections)
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
@@ -77,6 +116,13 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "a359ffaf.servicebinding.io",
 		SyncPeriod:             &syncPeriod,
+		MapperProvider:         newResettableRESTMapper,
+		// only the secrets owned by ServiceBindings are cached, binding secrets are otherwise read by name
+		NewCache: cache.BuilderWithOptions(cache.Options{
+			SelectorsByObject: cache.SelectorsByObject{
+				&corev1.Secret{}: {Label: controllers.BindingSecretCacheSelector()},
+			},
+		}),
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -86,9 +132,15 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 	config := reconcilers.NewConfig(mgr, &servicebindingv1beta1.ServiceBinding{}, syncPeriod)
 	accessChecker := rbac.NewAccessChecker(config, 5*time.Minute)
+	var authorAccessChecker rbac.SubjectAccessChecker
+	if enforceAuthorAccess {
+		authorAccessChecker = rbac.NewSubjectAccessChecker(config, 5*time.Minute)
+	}
 
 	serviceBindingController, err := controllers.ServiceBindingReconciler(
 		config,
+		authorAccessChecker,
+		bindingTypeProjections,
 	).SetupWithManagerYieldingController(ctx, mgr)
 	if err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ServiceBinding")
@@ -102,6 +154,30 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterWorkloadResourceMapping")
 		os.Exit(1)
 	}
+	if err = (&servicebindingv1beta1.WorkloadResourceMapping{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "WorkloadResourceMapping")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ClusterServiceResourceMapping{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterServiceResourceMapping")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.BindingTypeSchema{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "BindingTypeSchema")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.BindingPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "BindingPolicy")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ReferenceGrant{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ReferenceGrant")
+		os.Exit(1)
+	}
+	if err = (&servicebindingv1beta1.ServiceBindingPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ServiceBindingPolicy")
+		os.Exit(1)
+	}
 
 	if err = controllers.AdmissionProjectorReconciler(
 		config,
@@ -112,7 +188,7 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AdmissionProjector")
 		os.Exit(1)
 	}
-	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config).Build())
+	mgr.GetWebhookServer().Register("/interceptor", controllers.AdmissionProjectorWebhook(config, bindingTypeProjections).Build())
 
 	if err = controllers.TriggerReconciler(
 		config,
@@ -125,6 +201,45 @@ func main() {
 	}
 	mgr.GetWebhookServer().Register("/trigger", controllers.TriggerWebhook(config, serviceBindingController).Build())
 
+	if err = controllers.ClusterWorkloadResourceMappingReconciler(
+		config,
+		accessChecker,
+	).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterWorkloadResourceMapping")
+		os.Exit(1)
+	}
+	if enableMappingDiscovery {
+		if err = controllers.MappingDiscoveryReconciler(
+			config,
+		).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MappingDiscovery")
+			os.Exit(1)
+		}
+	}
+	if err = controllers.BindingPolicyReconciler(
+		config,
+	).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BindingPolicy")
+		os.Exit(1)
+	}
+	for _, kind := range strings.Split(workloadBindingsKinds, ",") {
+		if kind == "" {
+			continue
+		}
+		gvk, _ := schema.ParseKindArg(kind)
+		if gvk == nil {
+			setupLog.Error(nil, "invalid workload kind, expected Kind.version.group", "kind", kind)
+			os.Exit(1)
+		}
+		if err = controllers.WorkloadBindingsReconciler(
+			config,
+			*gvk,
+		).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "WorkloadBindings", "kind", kind)
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -142,3 +257,14 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// newResettableRESTMapper creates a mapper backed by cached discovery. The cache is reset, at most every
+// few seconds, when a CustomResourceDefinition is created, deleted or changes its served versions, so
+// that kinds are mapped as they are installed and uninstalled.
+func newResettableRESTMapper(config *rest.Config) (meta.RESTMapper, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)), nil
+}
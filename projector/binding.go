@@ -26,44 +26,123 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 )
 
 const (
-	ServiceBindingRootEnv    = "SERVICE_BINDING_ROOT"
-	Group                    = "projector.servicebinding.io"
-	VolumePrefix             = "servicebinding-"
-	SecretAnnotationPrefix   = Group + "/secret-"
-	TypeAnnotationPrefix     = Group + "/type-"
-	ProviderAnnotationPrefix = Group + "/provider-"
+	ServiceBindingRootEnv     = "SERVICE_BINDING_ROOT"
+	Group                     = "projector.servicebinding.io"
+	VolumePrefix              = "servicebinding-"
+	SecretAnnotationPrefix    = Group + "/secret-"
+	ConfigMapAnnotationPrefix = Group + "/configmap-"
+	TypeAnnotationPrefix      = Group + "/type-"
+	ProviderAnnotationPrefix  = Group + "/provider-"
+	BindingLabelPrefix        = Group + "/binding-"
+	// ImagePullSecretAnnotationPrefix records the image pull secret added by the binding, image pull secrets that were
+	// already present are left in place when the binding is unprojected
+	ImagePullSecretAnnotationPrefix = Group + "/image-pull-secret-"
+
+	// DockerRegistryType is the binding type for container registry credentials
+	DockerRegistryType = "docker-registry"
 )
 
+// TypeProjection declares the optional projections applied to bindings of a type, in addition to the volume, volume
+// mounts and environment variables that are projected for every binding. Each projection also requires the workload's
+// mapping to define the location to project into.
+type TypeProjection struct {
+	// Labels marks the pod template with a label identifying the binding
+	Labels bool
+	// EnvFrom exposes every entry of the binding secret as an environment variable within each bound container
+	EnvFrom bool
+	// ImagePullSecrets adds the binding secret to the pod template's image pull secrets
+	ImagePullSecrets bool
+}
+
+// DefaultTypeProjections returns the optional projections for well-known binding types. Bindings of a docker-registry
+// type are projected as image pull secrets.
+func DefaultTypeProjections() map[string]TypeProjection {
+	return map[string]TypeProjection{
+		DockerRegistryType: {
+			ImagePullSecrets: true,
+		},
+	}
+}
+
+// ParseTypeProjections parses the optional projections for binding types from a semicolon separated list of
+// type=projection entries, where projection is a comma separated list of labels, envFrom and imagePullSecrets. For
+// example "docker-registry=imagePullSecrets;mysql=labels,envFrom".
+func ParseTypeProjections(value string) (map[string]TypeProjection, error) {
+	typeProjections := map[string]TypeProjection{}
+	for _, entry := range strings.Split(value, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		bindingType, projections, ok := strings.Cut(entry, "=")
+		bindingType = strings.TrimSpace(bindingType)
+		if !ok || bindingType == "" {
+			return nil, fmt.Errorf("invalid type projection %q, expected type=projection", entry)
+		}
+		typeProjection := typeProjections[bindingType]
+		for _, projection := range strings.Split(projections, ",") {
+			switch strings.TrimSpace(projection) {
+			case "labels":
+				typeProjection.Labels = true
+			case "envFrom":
+				typeProjection.EnvFrom = true
+			case "imagePullSecrets":
+				typeProjection.ImagePullSecrets = true
+			default:
+				return nil, fmt.Errorf("unknown projection %q for type %q, expected labels, envFrom or imagePullSecrets", projection, bindingType)
+			}
+		}
+		typeProjections[bindingType] = typeProjection
+	}
+	return typeProjections, nil
+}
+
 var _ ServiceBindingProjector = (*serviceBindingProjector)(nil)
 
 type serviceBindingProjector struct {
-	mappingSource MappingSource
+	mappingSource   MappingSource
+	typeProjections map[string]TypeProjection
 }
 
 // New creates a service binding projector configured for the mapping source. The binding projector is typically created
 // once and applied to multiple workloads.
 func New(mappingSource MappingSource) ServiceBindingProjector {
+	return NewWithTypeProjections(mappingSource, DefaultTypeProjections())
+}
+
+// NewWithTypeProjections creates a service binding projector configured for the mapping source, applying optional
+// projections to bindings by their type.
+func NewWithTypeProjections(mappingSource MappingSource, typeProjections map[string]TypeProjection) ServiceBindingProjector {
 	return &serviceBindingProjector{
-		mappingSource: mappingSource,
+		mappingSource:   mappingSource,
+		typeProjections: typeProjections,
 	}
 }
 
 func (p *serviceBindingProjector) Project(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error {
+	return p.ProjectAll(ctx, []*servicebindingv1beta1.ServiceBinding{binding}, workload)
+}
+
+func (p *serviceBindingProjector) ProjectAll(ctx context.Context, bindings []*servicebindingv1beta1.ServiceBinding, workload runtime.Object) error {
 	mapping, err := p.mappingSource.LookupMapping(ctx, workload)
 	if err != nil {
 		return err
 	}
-	mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+	mpts, err := NewMetaPodTemplates(ctx, workload, mapping)
 	if err != nil {
 		return err
 	}
-	p.project(binding, mpt)
-	return mpt.WriteToWorkload(ctx)
+	for _, mpt := range mpts {
+		for _, binding := range bindings {
+			p.projectPodTemplate(binding, mpt)
+		}
+	}
+	return writeMetaPodTemplates(ctx, mpts)
 }
 
 func (p *serviceBindingProjector) Unproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error {
@@ -71,26 +150,44 @@ func (p *serviceBindingProjector) Unproject(ctx context.Context, binding *servic
 	if err != nil {
 		return err
 	}
-	mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+	mpts, err := NewMetaPodTemplates(ctx, workload, mapping)
 	if err != nil {
 		return err
 	}
-	p.unproject(binding, mpt)
-	return mpt.WriteToWorkload(ctx)
+	for _, mpt := range mpts {
+		p.unproject(binding, mpt)
+	}
+	return writeMetaPodTemplates(ctx, mpts)
+}
+
+// projectPodTemplate projects the binding into the pod template if the binding targets the pod template, otherwise
+// any prior projection is removed.
+func (p *serviceBindingProjector) projectPodTemplate(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	if !p.isPodTemplateBindable(binding, mpt) {
+		p.unproject(binding, mpt)
+		return
+	}
+	p.project(binding, mpt)
 }
 
 func (p *serviceBindingProjector) project(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
 	// rather than attempt to merge an existing binding, unproject it
 	p.unproject(binding, mpt)
 
-	if p.secretName(binding) == "" {
-		// no secret to bind
+	if p.secretName(binding) == "" && p.configMapName(binding) == "" && len(p.additionalBindings(binding)) == 0 {
+		// no secret or config map to bind
 		return
 	}
 	p.projectVolume(binding, mpt)
 	for i := range mpt.Containers {
 		p.projectContainer(binding, mpt, &mpt.Containers[i])
 	}
+	if p.typeProjection(binding).Labels {
+		p.projectLabel(binding, mpt)
+	}
+	if p.typeProjection(binding).ImagePullSecrets && p.secretName(binding) != "" {
+		p.projectImagePullSecret(binding, mpt)
+	}
 }
 
 func (p *serviceBindingProjector) unproject(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
@@ -98,9 +195,14 @@ func (p *serviceBindingProjector) unproject(binding *servicebindingv1beta1.Servi
 	for i := range mpt.Containers {
 		p.unprojectContainer(binding, mpt, &mpt.Containers[i])
 	}
+	p.unprojectImagePullSecret(binding, mpt)
+
+	// cleanup labels
+	delete(mpt.Labels, p.bindingLabelName(binding))
 
 	// cleanup annotations
 	delete(mpt.Annotations, p.secretAnnotationName(binding))
+	delete(mpt.Annotations, p.configMapAnnotationName(binding))
 	delete(mpt.Annotations, p.typeAnnotationName(binding))
 	delete(mpt.Annotations, p.providerAnnotationName(binding))
 }
@@ -110,17 +212,52 @@ func (p *serviceBindingProjector) projectVolume(binding *servicebindingv1beta1.S
 		Name: p.volumeName(binding),
 		VolumeSource: corev1.VolumeSource{
 			Projected: &corev1.ProjectedVolumeSource{
-				Sources: []corev1.VolumeProjection{
-					{
-						Secret: &corev1.SecretProjection{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: p.secretAnnotation(binding, mpt),
-							},
-						},
+				Sources: []corev1.VolumeProjection{},
+			},
+		},
+	}
+	if secret := p.secretAnnotation(binding, mpt); secret != "" {
+		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
+			corev1.VolumeProjection{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secret,
 					},
 				},
 			},
-		},
+		)
+	}
+	if configMap := p.configMapAnnotation(binding, mpt); configMap != "" {
+		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
+			corev1.VolumeProjection{
+				ConfigMap: &corev1.ConfigMapProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: configMap,
+					},
+				},
+			},
+		)
+	}
+	for _, additional := range p.additionalBindings(binding) {
+		projection := &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: additional.Binding.Name,
+			},
+		}
+		if additional.Path != "" {
+			// project the entries into the subdirectory of the binding
+			for _, key := range additional.Keys {
+				projection.Items = append(projection.Items, corev1.KeyToPath{
+					Key:  key,
+					Path: path.Join(additional.Path, key),
+				})
+			}
+		}
+		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
+			corev1.VolumeProjection{
+				Secret: projection,
+			},
+		)
 	}
 	if binding.Spec.Type != "" {
 		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
@@ -193,11 +330,15 @@ func (p *serviceBindingProjector) projectContainer(binding *servicebindingv1beta
 	}
 	p.projectVolumeMount(binding, mc)
 	p.projectEnv(binding, mpt, mc)
+	if p.typeProjection(binding).EnvFrom {
+		p.projectEnvFrom(binding, mpt, mc)
+	}
 }
 
 func (p *serviceBindingProjector) unprojectContainer(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
 	p.unprojectVolumeMount(binding, mc)
 	p.unprojectEnv(binding, mpt, mc)
+	p.unprojectEnvFrom(binding, mpt, mc)
 }
 
 func (p *serviceBindingProjector) projectVolumeMount(binding *servicebindingv1beta1.ServiceBinding, mc *metaContainer) {
@@ -261,26 +402,44 @@ func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.Serv
 			})
 			continue
 		}
-		mc.Env = append(mc.Env, corev1.EnvVar{
-			Name: e.Name,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: &corev1.SecretKeySelector{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: p.secretAnnotation(binding, mpt),
+		if secret := p.secretAnnotation(binding, mpt); secret != "" {
+			mc.Env = append(mc.Env, corev1.EnvVar{
+				Name: e.Name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secret,
+						},
+						Key: e.Key,
 					},
-					Key: e.Key,
 				},
-			},
-		})
+			})
+			continue
+		}
+		if configMap := p.configMapAnnotation(binding, mpt); configMap != "" {
+			mc.Env = append(mc.Env, corev1.EnvVar{
+				Name: e.Name,
+				ValueFrom: &corev1.EnvVarSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: configMap,
+						},
+						Key: e.Key,
+					},
+				},
+			})
+		}
+		// only additional services are bound, there is nothing to project the env var from
 	}
 
 	// sort projected env vars
 	secrets := p.knownProjectedSecrets(mpt)
+	configMaps := p.knownProjectedConfigMaps(mpt)
 	sort.SliceStable(mc.Env, func(i, j int) bool {
 		ii := mc.Env[i]
 		jj := mc.Env[j]
-		ip := p.isProjectedEnv(ii, secrets)
-		jp := p.isProjectedEnv(jj, secrets)
+		ip := p.isProjectedEnv(ii, secrets, configMaps)
+		jp := p.isProjectedEnv(jj, secrets, configMaps)
 		if ip && jp {
 			// sort projected items by name
 			return ii.Name < jj.Name
@@ -297,6 +456,7 @@ func (p *serviceBindingProjector) projectEnv(binding *servicebindingv1beta1.Serv
 func (p *serviceBindingProjector) unprojectEnv(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
 	env := []corev1.EnvVar{}
 	secret := mpt.Annotations[p.secretAnnotationName(binding)]
+	configMap := mpt.Annotations[p.configMapAnnotationName(binding)]
 	typeFieldPath := fmt.Sprintf("metadata.annotations['%s']", p.typeAnnotationName(binding))
 	providerFieldPath := fmt.Sprintf("metadata.annotations['%s']", p.providerAnnotationName(binding))
 	for _, e := range mc.Env {
@@ -306,6 +466,10 @@ func (p *serviceBindingProjector) unprojectEnv(binding *servicebindingv1beta1.Se
 			// projected from secret
 			remove = true
 		}
+		if configMap != "" && e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil && e.ValueFrom.ConfigMapKeyRef.Name == configMap {
+			// projected from config map
+			remove = true
+		}
 		if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil {
 			if e.ValueFrom.FieldRef.FieldPath == typeFieldPath {
 				// custom type env var
@@ -323,10 +487,115 @@ func (p *serviceBindingProjector) unprojectEnv(binding *servicebindingv1beta1.Se
 	mc.Env = env
 }
 
+func (p *serviceBindingProjector) projectEnvFrom(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
+	if secret := p.secretAnnotation(binding, mpt); secret != "" {
+		mc.EnvFrom = append(mc.EnvFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secret,
+				},
+			},
+		})
+	}
+	if configMap := p.configMapAnnotation(binding, mpt); configMap != "" {
+		mc.EnvFrom = append(mc.EnvFrom, corev1.EnvFromSource{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMap,
+				},
+			},
+		})
+	}
+}
+
+func (p *serviceBindingProjector) unprojectEnvFrom(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate, mc *metaContainer) {
+	envFrom := []corev1.EnvFromSource{}
+	secret := mpt.Annotations[p.secretAnnotationName(binding)]
+	configMap := mpt.Annotations[p.configMapAnnotationName(binding)]
+	for _, e := range mc.EnvFrom {
+		if e.SecretRef != nil && e.SecretRef.Name == secret {
+			continue
+		}
+		if configMap != "" && e.ConfigMapRef != nil && e.ConfigMapRef.Name == configMap {
+			continue
+		}
+		envFrom = append(envFrom, e)
+	}
+	mc.EnvFrom = envFrom
+}
+
+func (p *serviceBindingProjector) projectLabel(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	value := binding.Name
+	if len(validation.IsValidLabelValue(value)) != 0 {
+		// the binding is still identified by the label name
+		value = ""
+	}
+	mpt.Labels[p.bindingLabelName(binding)] = value
+}
+
+func (p *serviceBindingProjector) projectImagePullSecret(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	secret := p.secretAnnotation(binding, mpt)
+	if secret == "" {
+		return
+	}
+	for _, s := range mpt.ImagePullSecrets {
+		if s.Name == secret {
+			// already pulling with the secret
+			return
+		}
+	}
+	mpt.ImagePullSecrets = append(mpt.ImagePullSecrets, corev1.LocalObjectReference{
+		Name: secret,
+	})
+	mpt.Annotations[p.imagePullSecretAnnotationName(binding)] = secret
+}
+
+func (p *serviceBindingProjector) unprojectImagePullSecret(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) {
+	key := p.imagePullSecretAnnotationName(binding)
+	secret := mpt.Annotations[key]
+	delete(mpt.Annotations, key)
+	if secret == "" {
+		// the binding did not add an image pull secret
+		return
+	}
+	secrets := []corev1.LocalObjectReference{}
+	for _, s := range mpt.ImagePullSecrets {
+		if s.Name != secret {
+			secrets = append(secrets, s)
+		}
+	}
+	mpt.ImagePullSecrets = secrets
+}
+
+func (p *serviceBindingProjector) typeProjection(binding *servicebindingv1beta1.ServiceBinding) TypeProjection {
+	// the type is either defined on the binding, or resolved from the binding secret
+	bindingType := binding.Spec.Type
+	if bindingType == "" {
+		bindingType = binding.Status.Type
+	}
+	return p.typeProjections[bindingType]
+}
+
+func (p *serviceBindingProjector) isPodTemplateBindable(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) bool {
+	if len(binding.Spec.Workload.PodTemplates) == 0 || mpt.name == "" {
+		return true
+	}
+	for _, name := range binding.Spec.Workload.PodTemplates {
+		if name == mpt.name {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *serviceBindingProjector) isContainerBindable(binding *servicebindingv1beta1.ServiceBinding, mc *metaContainer) bool {
-	if len(binding.Spec.Workload.Containers) == 0 || mc.Name == nil {
+	if len(binding.Spec.Workload.Containers) == 0 {
 		return true
 	}
+	if mc.Name == nil {
+		// the mapping doesn't name the container, it can't be one of the selected containers
+		return false
+	}
 	for _, name := range binding.Spec.Workload.Containers {
 		if name == *mc.Name {
 			return true
@@ -350,11 +619,15 @@ func (p *serviceBindingProjector) serviceBindingRoot(mc *metaContainer) string {
 	return serviceBindingRoot.Value
 }
 
-func (p *serviceBindingProjector) isProjectedEnv(e corev1.EnvVar, secrets sets.String) bool {
+func (p *serviceBindingProjector) isProjectedEnv(e corev1.EnvVar, secrets, configMaps sets.String) bool {
 	if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && secrets.Has(e.ValueFrom.SecretKeyRef.Name) {
 		// projected from secret
 		return true
 	}
+	if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil && configMaps.Has(e.ValueFrom.ConfigMapKeyRef.Name) {
+		// projected from config map
+		return true
+	}
 	if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil && strings.HasPrefix(e.ValueFrom.FieldRef.FieldPath, fmt.Sprintf("metadata.annotations['%s", Group)) {
 		// projected custom type or annotation
 		return true
@@ -372,6 +645,16 @@ func (p *serviceBindingProjector) knownProjectedSecrets(mpt *metaPodTemplate) se
 	return secrets
 }
 
+func (p *serviceBindingProjector) knownProjectedConfigMaps(mpt *metaPodTemplate) sets.String {
+	configMaps := sets.NewString()
+	for k, v := range mpt.Annotations {
+		if strings.HasPrefix(k, ConfigMapAnnotationPrefix) {
+			configMaps.Insert(v)
+		}
+	}
+	return configMaps
+}
+
 func (p *serviceBindingProjector) secretName(binding *servicebindingv1beta1.ServiceBinding) string {
 	if binding.Status.Binding == nil {
 		return ""
@@ -393,6 +676,52 @@ func (p *serviceBindingProjector) secretAnnotationName(binding *servicebindingv1
 	return fmt.Sprintf("%s%s", SecretAnnotationPrefix, binding.UID)
 }
 
+// additionalBindings returns the additional services with a binding secret to project. Services whose secret is
+// projected into a path must have at least one key, a secret projection without items would project every entry into
+// the root of the binding.
+func (p *serviceBindingProjector) additionalBindings(binding *servicebindingv1beta1.ServiceBinding) []servicebindingv1beta1.ServiceBindingAdditionalServiceStatus {
+	additional := []servicebindingv1beta1.ServiceBindingAdditionalServiceStatus{}
+	for _, s := range binding.Status.AdditionalServices {
+		if s.Binding == nil || s.Binding.Name == "" {
+			continue
+		}
+		if s.Path != "" && len(s.Keys) == 0 {
+			continue
+		}
+		additional = append(additional, s)
+	}
+	return additional
+}
+
+func (p *serviceBindingProjector) configMapName(binding *servicebindingv1beta1.ServiceBinding) string {
+	if binding.Status.ConfigMap == nil {
+		return ""
+	}
+	return binding.Status.ConfigMap.Name
+}
+
+func (p *serviceBindingProjector) configMapAnnotation(binding *servicebindingv1beta1.ServiceBinding, mpt *metaPodTemplate) string {
+	key := p.configMapAnnotationName(binding)
+	configMap := p.configMapName(binding)
+	if configMap == "" {
+		return ""
+	}
+	mpt.Annotations[key] = configMap
+	return configMap
+}
+
+func (p *serviceBindingProjector) configMapAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", ConfigMapAnnotationPrefix, binding.UID)
+}
+
+func (p *serviceBindingProjector) imagePullSecretAnnotationName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", ImagePullSecretAnnotationPrefix, binding.UID)
+}
+
+func (p *serviceBindingProjector) bindingLabelName(binding *servicebindingv1beta1.ServiceBinding) string {
+	return fmt.Sprintf("%s%s", BindingLabelPrefix, binding.UID)
+}
+
 func (p *serviceBindingProjector) volumeName(binding *servicebindingv1beta1.ServiceBinding) string {
 	return fmt.Sprintf("%s%s", VolumePrefix, binding.UID)
 }
@@ -26,6 +26,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -36,6 +37,7 @@ func TestBinding(t *testing.T) {
 	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
 	bindingName := "my-binding"
 	secretName := "my-secret"
+	configMapName := "my-config-map"
 
 	tests := []struct {
 		name        string
@@ -628,6 +630,508 @@ func TestBinding(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "project config map binding",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "FOO",
+							Key:  "foo",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					ConfigMap: &servicebindingv1beta1.ServiceBindingConfigMapReference{
+						Name: configMapName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/configmap-26894874-4719-4802-8f43-8ceed127b4c2": configMapName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													ConfigMap: &corev1.ConfigMapProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: configMapName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: configMapName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "project config map alongside binding secret",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "FOO",
+							Key:  "foo",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+					ConfigMap: &servicebindingv1beta1.ServiceBindingConfigMapReference{
+						Name: configMapName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2":    secretName,
+								"projector.servicebinding.io/configmap-26894874-4719-4802-8f43-8ceed127b4c2": configMapName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+												{
+													ConfigMap: &corev1.ConfigMapProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: configMapName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: secretName,
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "project additional services",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+					AdditionalServices: []servicebindingv1beta1.ServiceBindingAdditionalServiceStatus{
+						{
+							Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+								Name: "my-root-secret",
+							},
+						},
+						{
+							Path: "cache",
+							Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+								Name: "my-cache-secret",
+							},
+							Keys: []string{"host", "port"},
+						},
+						{
+							// conflicting services are not projected
+							Path: "queue",
+						},
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-root-secret",
+														},
+													},
+												},
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-cache-secret",
+														},
+														Items: []corev1.KeyToPath{
+															{Key: "host", Path: "cache/host"},
+															{Key: "port", Path: "cache/port"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "project additional services only with env",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Env: []servicebindingv1beta1.EnvMapping{
+						{
+							Name: "HOST",
+							Key:  "host",
+						},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					AdditionalServices: []servicebindingv1beta1.ServiceBindingAdditionalServiceStatus{
+						{
+							Path: "cache",
+							Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+								Name: "my-cache-secret",
+							},
+							Keys: []string{"host"},
+						},
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-cache-secret",
+														},
+														Items: []corev1.KeyToPath{
+															{Key: "host", Path: "cache/host"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "remove config map binding",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/configmap-26894874-4719-4802-8f43-8ceed127b4c2": configMapName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													ConfigMap: &corev1.ConfigMapProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: configMapName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "FOO",
+											ValueFrom: &corev1.EnvVarSource{
+												ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+													Key: "foo",
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: configMapName,
+													},
+												},
+											},
+										},
+									},
+									EnvFrom: []corev1.EnvFromSource{
+										{
+											ConfigMapRef: &corev1.ConfigMapEnvSource{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: configMapName,
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+											ReadOnly:  true,
+											MountPath: "/bindings/my-binding",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									EnvFrom:      []corev1.EnvFromSource{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "remove service binding env",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
@@ -1288,6 +1792,93 @@ func TestBinding(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "do not bind unnamed containers when containers are selected",
+			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Annotations: ".spec.template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.template.spec.containers[*]",
+					},
+				},
+				Volumes: ".spec.template.spec.volumes",
+			}),
+			binding: &servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: uid,
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Name: bindingName,
+					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+						Containers: []string{"bind"},
+					},
+				},
+				Status: servicebindingv1beta1.ServiceBindingStatus{
+					Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+						Name: secretName,
+					},
+				},
+			},
+			workload: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "bind",
+								},
+								{
+									Name: "skip",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2": secretName,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{
+									Name: "servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: secretName,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name:         "bind",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+								{
+									Name:         "skip",
+									Env:          []corev1.EnvVar{},
+									VolumeMounts: []corev1.VolumeMount{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "only bind to allowed containers",
 			mapping: NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}),
@@ -2006,6 +2597,599 @@ func TestBinding(t *testing.T) {
 	}
 }
 
+func TestBinding_ProjectAll(t *testing.T) {
+	ctx := context.TODO()
+	mapping := NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})
+	bindings := []*servicebindingv1beta1.ServiceBinding{}
+	for i := 0; i < 3; i++ {
+		bindings = append(bindings, &servicebindingv1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: types.UID(fmt.Sprintf("26894874-4719-4802-8f43-8ceed127b4c%d", i)),
+			},
+			Spec: servicebindingv1beta1.ServiceBindingSpec{
+				Name:     fmt.Sprintf("my-binding-%d", i),
+				Type:     "my-type",
+				Provider: "my-provider",
+				Env: []servicebindingv1beta1.EnvMapping{
+					{
+						Name: fmt.Sprintf("TYPE_%d", i),
+						Key:  "type",
+					},
+					{
+						Name: fmt.Sprintf("USERNAME_%d", i),
+						Key:  "username",
+					},
+				},
+			},
+			Status: servicebindingv1beta1.ServiceBindingStatus{
+				Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+					Name: fmt.Sprintf("my-secret-%d", i),
+				},
+			},
+		})
+	}
+	workload, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToUnstructured() unexpected err: %v", err)
+	}
+
+	expected := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(workload)}
+	for _, binding := range bindings {
+		if err := New(mapping).Project(ctx, binding, expected); err != nil {
+			t.Fatalf("Project() unexpected err: %v", err)
+		}
+	}
+	actual := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(workload)}
+	if err := New(mapping).ProjectAll(ctx, bindings, actual); err != nil {
+		t.Fatalf("ProjectAll() unexpected err: %v", err)
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("ProjectAll() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestBinding_PodTemplates(t *testing.T) {
+	ctx := context.TODO()
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	mapping := NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Version: "*",
+		PodTemplates: []servicebindingv1beta1.ClusterWorkloadResourceMappingPodTemplate{
+			{
+				Name:        "driver",
+				Annotations: ".spec.driver.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.driver",
+					},
+				},
+				Volumes: ".spec.volumes.driver",
+			},
+			{
+				Name:        "executor",
+				Annotations: ".spec.executor.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.executor",
+					},
+				},
+				Volumes: ".spec.volumes.executor",
+			},
+		},
+	})
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	workload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "sparkoperator.k8s.io/v1beta2",
+			"kind":       "SparkApplication",
+			"spec": map[string]interface{}{
+				"driver": map[string]interface{}{
+					"cores": int64(1),
+				},
+				"executor": map[string]interface{}{
+					"cores": int64(2),
+				},
+			},
+		},
+	}
+	projectedPodTemplate := func(cores int64) map[string]interface{} {
+		return map[string]interface{}{
+			"cores": cores,
+			"annotations": map[string]interface{}{
+				fmt.Sprintf("projector.servicebinding.io/secret-%s", uid): "my-secret",
+			},
+			"env": []interface{}{
+				map[string]interface{}{
+					"name":  "SERVICE_BINDING_ROOT",
+					"value": "/bindings",
+				},
+			},
+			"volumeMounts": []interface{}{
+				map[string]interface{}{
+					"name":      fmt.Sprintf("servicebinding-%s", uid),
+					"mountPath": "/bindings/my-binding",
+					"readOnly":  true,
+				},
+			},
+		}
+	}
+	projectedVolumes := []interface{}{
+		map[string]interface{}{
+			"name": fmt.Sprintf("servicebinding-%s", uid),
+			"projected": map[string]interface{}{
+				"sources": []interface{}{
+					map[string]interface{}{
+						"secret": map[string]interface{}{
+							"name": "my-secret",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		podTemplates []string
+		seed         *unstructured.Unstructured
+		expected     *unstructured.Unstructured
+	}{
+		{
+			name: "all pod templates",
+			seed: workload.DeepCopy(),
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "sparkoperator.k8s.io/v1beta2",
+					"kind":       "SparkApplication",
+					"spec": map[string]interface{}{
+						"driver":   projectedPodTemplate(1),
+						"executor": projectedPodTemplate(2),
+						"volumes": map[string]interface{}{
+							"driver":   projectedVolumes,
+							"executor": projectedVolumes,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "selected pod template",
+			podTemplates: []string{"driver"},
+			seed:         workload.DeepCopy(),
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "sparkoperator.k8s.io/v1beta2",
+					"kind":       "SparkApplication",
+					"spec": map[string]interface{}{
+						"driver": projectedPodTemplate(1),
+						"executor": map[string]interface{}{
+							"cores":        int64(2),
+							"annotations":  map[string]interface{}{},
+							"env":          []interface{}{},
+							"volumeMounts": []interface{}{},
+						},
+						"volumes": map[string]interface{}{
+							"driver":   projectedVolumes,
+							"executor": []interface{}{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "unselected pod template is unprojected",
+			podTemplates: []string{"executor"},
+			seed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "sparkoperator.k8s.io/v1beta2",
+					"kind":       "SparkApplication",
+					"spec": map[string]interface{}{
+						"driver":   projectedPodTemplate(1),
+						"executor": projectedPodTemplate(2),
+						"volumes": map[string]interface{}{
+							"driver":   projectedVolumes,
+							"executor": projectedVolumes,
+						},
+					},
+				},
+			},
+			expected: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "sparkoperator.k8s.io/v1beta2",
+					"kind":       "SparkApplication",
+					"spec": map[string]interface{}{
+						"driver": map[string]interface{}{
+							"cores":       int64(1),
+							"annotations": map[string]interface{}{},
+							"env": []interface{}{
+								map[string]interface{}{
+									"name":  "SERVICE_BINDING_ROOT",
+									"value": "/bindings",
+								},
+							},
+							"volumeMounts": []interface{}{},
+						},
+						"executor": projectedPodTemplate(2),
+						"volumes": map[string]interface{}{
+							"driver":   []interface{}{},
+							"executor": projectedVolumes,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := binding.DeepCopy()
+			binding.Spec.Workload.PodTemplates = c.podTemplates
+			actual := c.seed.DeepCopy()
+			if err := New(mapping).Project(ctx, binding, actual); err != nil {
+				t.Fatalf("Project() unexpected err: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Project() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBinding_TypeProjections(t *testing.T) {
+	ctx := context.TODO()
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	mapping := NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-binding",
+			UID:  uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	workload := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+		},
+	}
+	typeProjections := map[string]TypeProjection{
+		"my-type": {
+			Labels:  true,
+			EnvFrom: true,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		bindingType     string
+		typeProjections map[string]TypeProjection
+		expected        corev1.PodTemplateSpec
+	}{
+		{
+			name:            "untyped",
+			typeProjections: typeProjections,
+		},
+		{
+			name:        "docker-registry",
+			bindingType: "docker-registry",
+			expected: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: []corev1.LocalObjectReference{
+						{
+							Name: "my-secret",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:            "labels and envFrom",
+			bindingType:     "my-type",
+			typeProjections: typeProjections,
+			expected: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						fmt.Sprintf("projector.servicebinding.io/binding-%s", uid): "my-binding",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							EnvFrom: []corev1.EnvFromSource{
+								{
+									SecretRef: &corev1.SecretEnvSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: "my-secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := binding.DeepCopy()
+			binding.Spec.Type = c.bindingType
+			projector := New(mapping)
+			if c.typeProjections != nil {
+				projector = NewWithTypeProjections(mapping, c.typeProjections)
+			}
+
+			actual := workload.DeepCopy()
+			if err := projector.Project(ctx, binding, actual); err != nil {
+				t.Fatalf("Project() unexpected err: %v", err)
+			}
+			template := actual.Spec.Template
+			if diff := cmp.Diff(c.expected.Labels, template.Labels); diff != "" {
+				t.Errorf("Project() labels (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.expected.Spec.ImagePullSecrets, template.Spec.ImagePullSecrets); diff != "" {
+				t.Errorf("Project() imagePullSecrets (-expected, +actual): %s", diff)
+			}
+			var expectedEnvFrom []corev1.EnvFromSource
+			if len(c.expected.Spec.Containers) != 0 {
+				expectedEnvFrom = c.expected.Spec.Containers[0].EnvFrom
+			}
+			if diff := cmp.Diff(expectedEnvFrom, template.Spec.Containers[0].EnvFrom); diff != "" {
+				t.Errorf("Project() envFrom (-expected, +actual): %s", diff)
+			}
+
+			if err := projector.Unproject(ctx, binding, actual); err != nil {
+				t.Fatalf("Unproject() unexpected err: %v", err)
+			}
+			if len(actual.Spec.Template.Labels) != 0 || len(actual.Spec.Template.Spec.ImagePullSecrets) != 0 || len(actual.Spec.Template.Spec.Containers[0].EnvFrom) != 0 {
+				t.Errorf("Unproject() expected type projections to be removed: %v", actual.Spec.Template)
+			}
+		})
+	}
+}
+
+func TestBinding_ImagePullSecretOwnership(t *testing.T) {
+	ctx := context.TODO()
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	projector := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}))
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-binding",
+			UID:  uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+			Type: DockerRegistryType,
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	annotation := fmt.Sprintf("projector.servicebinding.io/image-pull-secret-%s", uid)
+
+	tests := []struct {
+		name               string
+		imagePullSecrets   []corev1.LocalObjectReference
+		expectedProjected  []corev1.LocalObjectReference
+		expectedAnnotation string
+	}{
+		{
+			name:             "added by the binding",
+			imagePullSecrets: []corev1.LocalObjectReference{{Name: "other-secret"}},
+			expectedProjected: []corev1.LocalObjectReference{
+				{Name: "other-secret"},
+				{Name: "my-secret"},
+			},
+			expectedAnnotation: "my-secret",
+		},
+		{
+			name: "already present",
+			imagePullSecrets: []corev1.LocalObjectReference{
+				{Name: "my-secret"},
+				{Name: "other-secret"},
+			},
+			expectedProjected: []corev1.LocalObjectReference{
+				{Name: "my-secret"},
+				{Name: "other-secret"},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+								},
+							},
+							ImagePullSecrets: c.imagePullSecrets,
+						},
+					},
+				},
+			}
+
+			// projecting twice must not change ownership of the image pull secret
+			for i := 0; i < 2; i++ {
+				if err := projector.Project(ctx, binding, actual); err != nil {
+					t.Fatalf("Project() unexpected err: %v", err)
+				}
+				if diff := cmp.Diff(c.expectedProjected, actual.Spec.Template.Spec.ImagePullSecrets); diff != "" {
+					t.Errorf("Project() imagePullSecrets (-expected, +actual): %s", diff)
+				}
+				if diff := cmp.Diff(c.expectedAnnotation, actual.Spec.Template.Annotations[annotation]); diff != "" {
+					t.Errorf("Project() annotation (-expected, +actual): %s", diff)
+				}
+			}
+
+			if err := projector.Unproject(ctx, binding, actual); err != nil {
+				t.Fatalf("Unproject() unexpected err: %v", err)
+			}
+			if diff := cmp.Diff(c.imagePullSecrets, actual.Spec.Template.Spec.ImagePullSecrets); diff != "" {
+				t.Errorf("Unproject() imagePullSecrets (-expected, +actual): %s", diff)
+			}
+			if _, ok := actual.Spec.Template.Annotations[annotation]; ok {
+				t.Errorf("Unproject() expected annotation %q to be removed", annotation)
+			}
+		})
+	}
+}
+
+func TestBinding_TypeProjectionFromStatus(t *testing.T) {
+	ctx := context.TODO()
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	projector := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}))
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-binding",
+			UID:  uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+			// resolved from the type entry of the binding secret
+			Type: DockerRegistryType,
+		},
+	}
+	actual := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := projector.Project(ctx, binding, actual); err != nil {
+		t.Fatalf("Project() unexpected err: %v", err)
+	}
+	expected := []corev1.LocalObjectReference{{Name: "my-secret"}}
+	if diff := cmp.Diff(expected, actual.Spec.Template.Spec.ImagePullSecrets); diff != "" {
+		t.Errorf("Project() imagePullSecrets (-expected, +actual): %s", diff)
+	}
+
+	if err := projector.Unproject(ctx, binding, actual); err != nil {
+		t.Fatalf("Unproject() unexpected err: %v", err)
+	}
+	if len(actual.Spec.Template.Spec.ImagePullSecrets) != 0 {
+		t.Errorf("Unproject() expected imagePullSecrets to be removed, got %v", actual.Spec.Template.Spec.ImagePullSecrets)
+	}
+}
+
+func TestParseTypeProjections(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    map[string]TypeProjection
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			value:    "",
+			expected: map[string]TypeProjection{},
+		},
+		{
+			name:  "default",
+			value: "docker-registry=imagePullSecrets",
+			expected: map[string]TypeProjection{
+				DockerRegistryType: {ImagePullSecrets: true},
+			},
+		},
+		{
+			name:  "multiple types",
+			value: "docker-registry=imagePullSecrets; mysql=labels,envFrom;",
+			expected: map[string]TypeProjection{
+				DockerRegistryType: {ImagePullSecrets: true},
+				"mysql":            {Labels: true, EnvFrom: true},
+			},
+		},
+		{
+			name:        "missing projections",
+			value:       "mysql",
+			expectedErr: true,
+		},
+		{
+			name:        "missing type",
+			value:       "=labels",
+			expectedErr: true,
+		},
+		{
+			name:        "unknown projection",
+			value:       "mysql=volumes",
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ParseTypeProjections(c.value)
+			if (err != nil) != c.expectedErr {
+				t.Fatalf("ParseTypeProjections() expected err: %v, actual err: %v", c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ParseTypeProjections() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 var (
 	_ runtime.Object = (*BadMarshalJSON)(nil)
 )
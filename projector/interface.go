@@ -27,8 +27,19 @@ import (
 type ServiceBindingProjector interface {
 	// Project the service into the workload as defined by the ServiceBinding.
 	Project(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error
+	// ProjectAll projects each service into the workload as defined by the ServiceBindings. The workload is converted
+	// to and from its mapped form once for all bindings, rather than once per binding.
+	ProjectAll(ctx context.Context, bindings []*servicebindingv1beta1.ServiceBinding, workload runtime.Object) error
 	// Unproject the serice from the workload as defined by the ServiceBinding.
 	Unproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) error
+	// PlanProject describes the changes projecting the service into the workload would make. The workload is not mutated.
+	PlanProject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error)
+	// PlanUnproject describes the changes unprojecting the service from the workload would make. The workload is not
+	// mutated.
+	PlanUnproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error)
+	// PlanChanges describes the changes between two revisions of the workload, typically before and after projecting
+	// several ServiceBindings. Neither workload is mutated.
+	PlanChanges(ctx context.Context, original, modified runtime.Object) (*Plan, error)
 }
 
 type MappingSource interface {
@@ -0,0 +1,316 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// parseJsonPath parses the expression into a flat list of nodes.
+func parseJsonPath(expression string) ([]jsonpath.Node, error) {
+	p, err := jsonpath.Parse("", fmt.Sprintf("{%s}", expression))
+	if err != nil {
+		return nil, err
+	}
+	return flattenJsonPath(p.Root), nil
+}
+
+func flattenJsonPath(node jsonpath.Node) []jsonpath.Node {
+	if list, ok := node.(*jsonpath.ListNode); ok {
+		nodes := []jsonpath.Node{}
+		for i := range list.Nodes {
+			nodes = append(nodes, flattenJsonPath(list.Nodes[i])...)
+		}
+		return nodes
+	}
+	return []jsonpath.Node{node}
+}
+
+// evaluateJsonPath resolves the nodes against each value returning every match. Unlike the jsonpath package, map
+// entries are visited in key order so that repeated evaluations over equivalent content resolve matches in the same
+// order. Values that do not match are skipped rather than reported as an error.
+func evaluateJsonPath(nodes []jsonpath.Node, values []reflect.Value) ([]reflect.Value, error) {
+	for _, node := range nodes {
+		results := []reflect.Value{}
+		for _, value := range values {
+			value = indirect(value)
+			if !value.IsValid() {
+				continue
+			}
+			switch n := node.(type) {
+			case *jsonpath.ListNode:
+				r, err := evaluateJsonPath(flattenJsonPath(n), []reflect.Value{value})
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, r...)
+			case *jsonpath.FieldNode:
+				if value.Kind() != reflect.Map {
+					continue
+				}
+				if v := value.MapIndex(reflect.ValueOf(n.Value)); v.IsValid() {
+					results = append(results, v)
+				}
+			case *jsonpath.ArrayNode:
+				if value.Kind() != reflect.Slice {
+					continue
+				}
+				start, end, step := arrayBounds(n, value.Len())
+				for i := start; i < end; i += step {
+					results = append(results, value.Index(i))
+				}
+			case *jsonpath.FilterNode:
+				if value.Kind() != reflect.Slice {
+					continue
+				}
+				for i := 0; i < value.Len(); i++ {
+					match, err := matchesFilter(value.Index(i), n)
+					if err != nil {
+						return nil, err
+					}
+					if match {
+						results = append(results, value.Index(i))
+					}
+				}
+			case *jsonpath.WildcardNode:
+				results = append(results, children(value)...)
+			case *jsonpath.RecursiveNode:
+				results = append(results, descendants(value)...)
+			case *jsonpath.UnionNode:
+				for _, list := range n.Nodes {
+					r, err := evaluateJsonPath(flattenJsonPath(list), []reflect.Value{value})
+					if err != nil {
+						return nil, err
+					}
+					results = append(results, r...)
+				}
+			default:
+				return nil, fmt.Errorf("unsupported node type %q found", node.Type())
+			}
+		}
+		values = results
+	}
+	return values, nil
+}
+
+// selectElement returns the index of the single slice element selected by the node, or -1 if no element is selected.
+func selectElement(value reflect.Value, node jsonpath.Node) (int, error) {
+	switch n := node.(type) {
+	case *jsonpath.ArrayNode:
+		start, end, _ := arrayBounds(n, value.Len())
+		if start >= end {
+			return -1, nil
+		}
+		return start, nil
+	case *jsonpath.FilterNode:
+		for i := 0; i < value.Len(); i++ {
+			match, err := matchesFilter(value.Index(i), n)
+			if err != nil {
+				return -1, err
+			}
+			if match {
+				return i, nil
+			}
+		}
+		return -1, nil
+	default:
+		return -1, fmt.Errorf("unsupported node type %q found", node.Type())
+	}
+}
+
+func arrayBounds(node *jsonpath.ArrayNode, length int) (int, int, int) {
+	params := node.Params
+	start, end, step := 0, length, 1
+	if params[0].Known {
+		start = params[0].Value
+	}
+	if start < 0 {
+		start += length
+	}
+	if params[1].Known {
+		end = params[1].Value
+		if params[1].Derived {
+			// single index, the end is relative to the start
+			end = start + 1
+		} else if end < 0 {
+			end += length
+		}
+	}
+	if params[2].Known && params[2].Value > 0 {
+		step = params[2].Value
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end > length {
+		end = length
+	}
+	return start, end, step
+}
+
+func matchesFilter(value reflect.Value, filter *jsonpath.FilterNode) (bool, error) {
+	left, ok, err := filterOperand(value, filter.Left)
+	if err != nil {
+		return false, err
+	}
+	if filter.Operator == "exists" {
+		return ok, nil
+	}
+	if !ok {
+		return false, nil
+	}
+	right, ok, err := filterOperand(value, filter.Right)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if lf, lok := toFloat(left); lok {
+		if rf, rok := toFloat(right); rok {
+			switch filter.Operator {
+			case "==":
+				return lf == rf, nil
+			case "!=":
+				return lf != rf, nil
+			case "<":
+				return lf < rf, nil
+			case ">":
+				return lf > rf, nil
+			case "<=":
+				return lf <= rf, nil
+			case ">=":
+				return lf >= rf, nil
+			}
+		}
+	}
+	if ls, lok := left.(string); lok {
+		if rs, rok := right.(string); rok {
+			switch filter.Operator {
+			case "<":
+				return ls < rs, nil
+			case ">":
+				return ls > rs, nil
+			case "<=":
+				return ls <= rs, nil
+			case ">=":
+				return ls >= rs, nil
+			}
+		}
+	}
+	switch filter.Operator {
+	case "==":
+		return reflect.DeepEqual(left, right), nil
+	case "!=":
+		return !reflect.DeepEqual(left, right), nil
+	case "<", ">", "<=", ">=":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unrecognized filter operator %s", filter.Operator)
+	}
+}
+
+func filterOperand(value reflect.Value, list *jsonpath.ListNode) (interface{}, bool, error) {
+	nodes := flattenJsonPath(list)
+	if len(nodes) == 1 {
+		switch n := nodes[0].(type) {
+		case *jsonpath.TextNode:
+			return n.Text, true, nil
+		case *jsonpath.IntNode:
+			return n.Value, true, nil
+		case *jsonpath.FloatNode:
+			return n.Value, true, nil
+		case *jsonpath.BoolNode:
+			return n.Value, true, nil
+		}
+	}
+	results, err := evaluateJsonPath(nodes, []reflect.Value{value})
+	if err != nil {
+		return nil, false, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, false, nil
+	case 1:
+		v := indirect(results[0])
+		if !v.IsValid() {
+			return nil, false, nil
+		}
+		return v.Interface(), true, nil
+	default:
+		return nil, false, fmt.Errorf("can only compare one element at a time")
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func children(value reflect.Value) []reflect.Value {
+	results := []reflect.Value{}
+	switch value.Kind() {
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			results = append(results, value.MapIndex(key))
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			results = append(results, value.Index(i))
+		}
+	}
+	return results
+}
+
+func descendants(value reflect.Value) []reflect.Value {
+	results := []reflect.Value{value}
+	for _, child := range children(value) {
+		if child = indirect(child); child.IsValid() {
+			results = append(results, descendants(child)...)
+		}
+	}
+	return results
+}
+
+// indirect unwraps interfaces, returning an invalid value for nil.
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if value.IsValid() && (value.Kind() == reflect.Map || value.Kind() == reflect.Slice) && value.IsNil() {
+		return reflect.Value{}
+	}
+	return value
+}
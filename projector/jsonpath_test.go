@@ -0,0 +1,134 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluateJsonPath(t *testing.T) {
+	source := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"name": "a", "kind": "app", "replicas": int64(1)},
+			map[string]interface{}{"name": "b", "kind": "db", "replicas": int64(3)},
+			map[string]interface{}{"name": "c", "kind": "app", "replicas": 2.5, "optional": true},
+		},
+		"roles": map[string]interface{}{
+			"z": map[string]interface{}{"name": "z"},
+			"m": map[string]interface{}{"name": "m"},
+			"a": map[string]interface{}{"name": "a"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expression  string
+		expected    []interface{}
+		expectedErr bool
+	}{
+		{
+			name:       "field",
+			expression: ".items[0].name",
+			expected:   []interface{}{"a"},
+		},
+		{
+			name:       "missing field",
+			expression: ".missing.name",
+			expected:   []interface{}{},
+		},
+		{
+			name:       "negative index",
+			expression: ".items[-1].name",
+			expected:   []interface{}{"c"},
+		},
+		{
+			name:       "index out of range",
+			expression: ".items[5].name",
+			expected:   []interface{}{},
+		},
+		{
+			name:       "slice",
+			expression: ".items[1:].name",
+			expected:   []interface{}{"b", "c"},
+		},
+		{
+			name:       "array wildcard",
+			expression: ".items[*].name",
+			expected:   []interface{}{"a", "b", "c"},
+		},
+		{
+			name:       "map wildcard in key order",
+			expression: ".roles.*.name",
+			expected:   []interface{}{"a", "m", "z"},
+		},
+		{
+			name:       "filter equal",
+			expression: `.items[?(@.kind=="app")].name`,
+			expected:   []interface{}{"a", "c"},
+		},
+		{
+			name:       "filter not equal",
+			expression: `.items[?(@.kind!="app")].name`,
+			expected:   []interface{}{"b"},
+		},
+		{
+			name:       "filter numeric",
+			expression: ".items[?(@.replicas>=2)].name",
+			expected:   []interface{}{"b", "c"},
+		},
+		{
+			name:       "filter exists",
+			expression: ".items[?(@.optional)].name",
+			expected:   []interface{}{"c"},
+		},
+		{
+			name:       "union",
+			expression: ".items[0,2].name",
+			expected:   []interface{}{"a", "c"},
+		},
+		{
+			name:        "unsupported",
+			expression:  "'foo'",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			nodes, err := parseJsonPath(c.expression)
+			if err == nil {
+				var results []reflect.Value
+				results, err = evaluateJsonPath(nodes, []reflect.Value{reflect.ValueOf(source)})
+				if err == nil {
+					actual := []interface{}{}
+					for _, result := range results {
+						actual = append(actual, indirect(result).Interface())
+					}
+					if diff := cmp.Diff(c.expected, actual); diff != "" {
+						t.Errorf("evaluateJsonPath() (-expected, +actual): %s", diff)
+					}
+				}
+			}
+			if (err != nil) != c.expectedErr {
+				t.Errorf("evaluateJsonPath() expected err: %v", err)
+			}
+		})
+	}
+}
@@ -34,78 +34,171 @@ import (
 type metaPodTemplate struct {
 	workload runtime.Object
 	mapping  *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+	// name of the pod template within the workload, empty unless the mapping defines pod templates
+	name string
+	// unstructured is the form of the workload the reflective path reads from and writes to, shared by each pod
+	// template of the workload so that writes are cumulative
+	unstructured map[string]interface{}
+	// containerMatches are the container-like fragments of unstructured matched by the mapping when read, in the same
+	// order as Containers. The matches are reused when writing, as re-evaluating the container paths against the
+	// modified workload may match a different set of containers.
+	containerMatches []containerMatch
 
-	Annotations map[string]string
-	Containers  []metaContainer
-	Volumes     []corev1.Volume
+	Annotations      map[string]string
+	Labels           map[string]string
+	Containers       []metaContainer
+	Volumes          []corev1.Volume
+	ImagePullSecrets []corev1.LocalObjectReference
+}
+
+// containerMatch is a container-like fragment of the workload matched by the container path at index path of the
+// mapping
+type containerMatch struct {
+	path  int
+	value reflect.Value
 }
 
 // metaContainer contains the aspects of a Container that are appropriate for service binding.
 type metaContainer struct {
 	Name         *string
 	Env          []corev1.EnvVar
+	EnvFrom      []corev1.EnvFromSource
 	VolumeMounts []corev1.VolumeMount
 }
 
+// NewMetaPodTemplates coerces the workload object into a MetaPodTemplate for each pod template defined by the
+// mapping. A mapping without pod templates results in a single MetaPodTemplate.
+func NewMetaPodTemplates(ctx context.Context, workload runtime.Object, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) ([]*metaPodTemplate, error) {
+	if len(mapping.PodTemplates) == 0 {
+		mpt, err := NewMetaPodTemplate(ctx, workload, mapping)
+		if err != nil {
+			return nil, err
+		}
+		return []*metaPodTemplate{mpt}, nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+	if err != nil {
+		return nil, err
+	}
+	mpts := make([]*metaPodTemplate, len(mapping.PodTemplates))
+	for i, pt := range mapping.PodTemplates {
+		mpt, err := newMetaPodTemplate(ctx, workload, u, &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+			Version:          mapping.Version,
+			Annotations:      pt.Annotations,
+			Labels:           pt.Labels,
+			Containers:       pt.Containers,
+			Volumes:          pt.Volumes,
+			ImagePullSecrets: pt.ImagePullSecrets,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mpt.name = pt.Name
+		mpts[i] = mpt
+	}
+	return mpts, nil
+}
+
+// writeMetaPodTemplates applies each MetaPodTemplate to the workload in turn. Pod templates are expected to reference
+// distinct locations within the workload.
+func writeMetaPodTemplates(ctx context.Context, mpts []*metaPodTemplate) error {
+	for _, mpt := range mpts {
+		if err := mpt.WriteToWorkload(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMetaPodTemplate coerces the workload object into a MetaPodTemplate following the mapping definition. The
 // resulting MetaPodTemplate may have one or more service bindings applied to it at a time, but should not be reused.
 // The workload must be JSON marshalable.
 func NewMetaPodTemplate(ctx context.Context, workload runtime.Object, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) (*metaPodTemplate, error) {
+	return newMetaPodTemplate(ctx, workload, nil, mapping)
+}
+
+// newMetaPodTemplate creates a MetaPodTemplate like NewMetaPodTemplate. The unstructured form of the workload is
+// shared when not nil, otherwise the workload is converted as needed.
+func newMetaPodTemplate(ctx context.Context, workload runtime.Object, u map[string]interface{}, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) (*metaPodTemplate, error) {
 	mpt := &metaPodTemplate{
 		workload: workload,
 		mapping:  mapping,
 
-		Annotations: map[string]string{},
-		Containers:  []metaContainer{},
-		Volumes:     []corev1.Volume{},
+		Annotations:      map[string]string{},
+		Labels:           map[string]string{},
+		Containers:       []metaContainer{},
+		Volumes:          []corev1.Volume{},
+		ImagePullSecrets: []corev1.LocalObjectReference{},
 	}
 
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
-	if err != nil {
-		return nil, err
+	if template, typed := typedPodTemplateSpec(workload, mapping); template != nil {
+		// fast path for well-known workload types
+		mpt.readPodTemplateSpec(template, typed)
+		return mpt, nil
 	}
+	if template, typed := unstructuredPodTemplateSpec(workload, mapping); template != nil {
+		// fast path for well-known workload types in an unstructured form
+		if err := mpt.readUnstructuredPodTemplateSpec(template, typed); err != nil {
+			return nil, err
+		}
+		return mpt, nil
+	}
+
+	if u == nil {
+		var err error
+		u, err = runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mpt.unstructured = u
 	uv := reflect.ValueOf(u)
 
 	if err := mpt.getAt(mpt.mapping.Annotations, uv, &mpt.Annotations); err != nil {
 		return nil, err
 	}
-	for i := range mpt.mapping.Containers {
-		cp := jsonpath.New("")
-		if err := cp.Parse(fmt.Sprintf("{%s}", mpt.mapping.Containers[i].Path)); err != nil {
-			return nil, err
-		}
-		cr, err := cp.FindResults(u)
-		if err != nil {
-			// errors are expected if a path is not found
-			continue
+	if err := mpt.getOptionalAt(mpt.mapping.Labels, uv, &mpt.Labels); err != nil {
+		return nil, err
+	}
+	matches, err := mpt.matchContainers(uv)
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range matches {
+		i, cv := match.path, match.value
+		mc := metaContainer{
+			Name:         nil,
+			Env:          []corev1.EnvVar{},
+			EnvFrom:      []corev1.EnvFromSource{},
+			VolumeMounts: []corev1.VolumeMount{},
 		}
-		for _, cv := range cr[0] {
-			mc := metaContainer{
-				Name:         nil,
-				Env:          []corev1.EnvVar{},
-				VolumeMounts: []corev1.VolumeMount{},
-			}
 
-			if mpt.mapping.Containers[i].Name != "" {
-				// name is optional
-				mc.Name = pointer.String("")
-				if err := mpt.getAt(mpt.mapping.Containers[i].Name, cv, mc.Name); err != nil {
-					return nil, err
-				}
-			}
-			if err := mpt.getAt(mpt.mapping.Containers[i].Env, cv, &mc.Env); err != nil {
+		if mpt.mapping.Containers[i].Name != "" {
+			// name is optional
+			mc.Name = pointer.String("")
+			if err := mpt.getAt(mpt.mapping.Containers[i].Name, cv, mc.Name); err != nil {
 				return nil, err
 			}
-			if err := mpt.getAt(mpt.mapping.Containers[i].VolumeMounts, cv, &mc.VolumeMounts); err != nil {
-				return nil, err
-			}
-
-			mpt.Containers = append(mpt.Containers, mc)
 		}
+		if err := mpt.getAt(mpt.mapping.Containers[i].Env, cv, &mc.Env); err != nil {
+			return nil, err
+		}
+		if err := mpt.getOptionalAt(mpt.mapping.Containers[i].EnvFrom, cv, &mc.EnvFrom); err != nil {
+			return nil, err
+		}
+		if err := mpt.getAt(mpt.mapping.Containers[i].VolumeMounts, cv, &mc.VolumeMounts); err != nil {
+			return nil, err
+		}
+
+		mpt.Containers = append(mpt.Containers, mc)
 	}
+	mpt.containerMatches = matches
 	if err := mpt.getAt(mpt.mapping.Volumes, uv, &mpt.Volumes); err != nil {
 		return nil, err
 	}
+	if err := mpt.getOptionalAt(mpt.mapping.ImagePullSecrets, uv, &mpt.ImagePullSecrets); err != nil {
+		return nil, err
+	}
 
 	return mpt, nil
 }
@@ -113,46 +206,64 @@ func NewMetaPodTemplate(ctx context.Context, workload runtime.Object, mapping *s
 // WriteToWorkload applies mutation defined on the MetaPodTemplate since it was created to the workload resource the
 // MetaPodTemplate was created from. This method should generally be called once per instance.
 func (mpt *metaPodTemplate) WriteToWorkload(ctx context.Context) error {
-	// convert structured workload to unstructured
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mpt.workload)
-	if err != nil {
-		return err
+	if template, typed := typedPodTemplateSpec(mpt.workload, mpt.mapping); template != nil {
+		// fast path for well-known workload types
+		mpt.writePodTemplateSpec(template, typed)
+		return nil
+	}
+	if template, typed := unstructuredPodTemplateSpec(mpt.workload, mpt.mapping); template != nil {
+		// fast path for well-known workload types in an unstructured form
+		return mpt.writeUnstructuredPodTemplateSpec(template, typed)
+	}
+
+	if mpt.unstructured == nil {
+		// the template was not read from the workload, resolve the containers to write
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mpt.workload)
+		if err != nil {
+			return err
+		}
+		matches, err := mpt.matchContainers(reflect.ValueOf(u))
+		if err != nil {
+			return err
+		}
+		if len(matches) != len(mpt.Containers) {
+			return fmt.Errorf("the container paths of the mapping match %d containers, expected %d", len(matches), len(mpt.Containers))
+		}
+		mpt.unstructured, mpt.containerMatches = u, matches
 	}
+	u := mpt.unstructured
 	uv := reflect.ValueOf(u)
 
 	if err := mpt.setAt(mpt.mapping.Annotations, &mpt.Annotations, uv); err != nil {
 		return err
 	}
-	ci := 0
-	for i := range mpt.mapping.Containers {
-		cp := jsonpath.New("")
-		if err := cp.Parse(fmt.Sprintf("{%s}", mpt.mapping.Containers[i].Path)); err != nil {
+	if err := mpt.setOptionalAt(mpt.mapping.Labels, &mpt.Labels, uv); err != nil {
+		return err
+	}
+	// containers are written to the fragments matched when read
+	for ci, match := range mpt.containerMatches {
+		i, cv := match.path, match.value
+		if mpt.mapping.Containers[i].Name != "" && mpt.Containers[ci].Name != nil {
+			if err := mpt.setAt(mpt.mapping.Containers[i].Name, mpt.Containers[ci].Name, cv); err != nil {
+				return err
+			}
+		}
+		if err := mpt.setAt(mpt.mapping.Containers[i].Env, &mpt.Containers[ci].Env, cv); err != nil {
 			return err
 		}
-		cr, err := cp.FindResults(u)
-		if err != nil {
-			// errors are expected if a path is not found
-			continue
+		if err := mpt.setOptionalAt(mpt.mapping.Containers[i].EnvFrom, &mpt.Containers[ci].EnvFrom, cv); err != nil {
+			return err
 		}
-		for _, cv := range cr[0] {
-			if mpt.mapping.Containers[i].Name != "" && mpt.Containers[ci].Name != nil {
-				if err := mpt.setAt(mpt.mapping.Containers[i].Name, mpt.Containers[ci].Name, cv); err != nil {
-					return err
-				}
-			}
-			if err := mpt.setAt(mpt.mapping.Containers[i].Env, &mpt.Containers[ci].Env, cv); err != nil {
-				return err
-			}
-			if err := mpt.setAt(mpt.mapping.Containers[i].VolumeMounts, &mpt.Containers[ci].VolumeMounts, cv); err != nil {
-				return err
-			}
-
-			ci++
+		if err := mpt.setAt(mpt.mapping.Containers[i].VolumeMounts, &mpt.Containers[ci].VolumeMounts, cv); err != nil {
+			return err
 		}
 	}
 	if err := mpt.setAt(mpt.mapping.Volumes, &mpt.Volumes, uv); err != nil {
 		return err
 	}
+	if err := mpt.setOptionalAt(mpt.mapping.ImagePullSecrets, &mpt.ImagePullSecrets, uv); err != nil {
+		return err
+	}
 
 	// mutate workload with update content from unstructured
 	return runtime.DefaultUnstructuredConverter.FromUnstructured(u, mpt.workload)
@@ -169,7 +280,7 @@ func (mpt *metaPodTemplate) getAt(ptr string, source reflect.Value, target inter
 	if err != nil {
 		return err
 	}
-	if !v.IsValid() || v.IsNil() {
+	if !v.IsValid() || isNil(v) {
 		return nil
 	}
 	b, err := json.Marshal(v.Interface())
@@ -190,6 +301,10 @@ func (mpt *metaPodTemplate) setAt(ptr string, value interface{}, target reflect.
 	if err != nil {
 		return err
 	}
+	if !vp.IsValid() {
+		// the location is not addressable within the workload
+		return nil
+	}
 	b, err := json.Marshal(value)
 	if err != nil {
 		return err
@@ -212,40 +327,106 @@ func (mpt *metaPodTemplate) setAt(ptr string, value interface{}, target reflect.
 	return nil
 }
 
-func (mpt *metaPodTemplate) keys(ptr string) ([]string, error) {
-	p, err := jsonpath.Parse("", fmt.Sprintf("{%s}", ptr))
+// getOptionalAt gets the value like getAt, unless the location is not defined by the mapping.
+func (mpt *metaPodTemplate) getOptionalAt(ptr string, source reflect.Value, target interface{}) error {
+	if ptr == "" {
+		return nil
+	}
+	return mpt.getAt(ptr, source, target)
+}
+
+// setOptionalAt sets the value like setAt, unless the location is not defined by the mapping. An empty value is only
+// written if the location already exists within the workload, workloads without the optional location are left as is.
+func (mpt *metaPodTemplate) setOptionalAt(ptr string, value interface{}, target reflect.Value) error {
+	if ptr == "" {
+		return nil
+	}
+	if reflect.ValueOf(value).Elem().Len() == 0 {
+		keys, err := mpt.keys(ptr)
+		if err != nil {
+			return err
+		}
+		v, _, _, err := mpt.find(target, reflect.ValueOf(nil), keys, "", false)
+		if err != nil {
+			return err
+		}
+		if !v.IsValid() || isNil(v) {
+			return nil
+		}
+	}
+	return mpt.setAt(ptr, value, target)
+}
+
+// matchContainers resolves the container-like fragments of the workload for each container path of the mapping
+func (mpt *metaPodTemplate) matchContainers(source reflect.Value) ([]containerMatch, error) {
+	matches := []containerMatch{}
+	for i := range mpt.mapping.Containers {
+		cr, err := mpt.containers(mpt.mapping.Containers[i].Path, source)
+		if err != nil {
+			return nil, err
+		}
+		for _, cv := range cr {
+			matches = append(matches, containerMatch{path: i, value: cv})
+		}
+	}
+	return matches, nil
+}
+
+// containers resolves the container-like fragments of the workload matching the path. Only object values are
+// container-like, other matches are ignored.
+func (mpt *metaPodTemplate) containers(path string, source reflect.Value) ([]reflect.Value, error) {
+	nodes, err := parseJsonPath(path)
+	if err != nil {
+		return nil, err
+	}
+	results, err := evaluateJsonPath(nodes, []reflect.Value{source})
 	if err != nil {
 		return nil, err
 	}
-	return mpt.fieldKeys(p.Root)
+	containers := []reflect.Value{}
+	for _, result := range results {
+		if result = indirect(result); result.IsValid() && result.Kind() == reflect.Map {
+			containers = append(containers, result)
+		}
+	}
+	return containers, nil
 }
 
-func (mpt *metaPodTemplate) fieldKeys(node jsonpath.Node) ([]string, error) {
-	switch node.Type() {
-	case jsonpath.NodeList:
-		list := node.(*jsonpath.ListNode)
-		paths := []string{}
-		for i := range list.Nodes {
-			nestedpaths, err := mpt.fieldKeys(list.Nodes[i])
-			if err != nil {
-				return nil, err
+func (mpt *metaPodTemplate) keys(ptr string) ([]jsonpath.Node, error) {
+	nodes, err := parseJsonPath(ptr)
+	if err != nil {
+		return nil, err
+	}
+	for i, node := range nodes {
+		switch n := node.(type) {
+		case *jsonpath.FieldNode:
+			continue
+		case *jsonpath.ArrayNode:
+			if n.Params[0].Known && n.Params[1].Derived && i != len(nodes)-1 {
+				// single index
+				continue
+			}
+		case *jsonpath.FilterNode:
+			if i != len(nodes)-1 {
+				continue
 			}
-			paths = append(paths, nestedpaths...)
 		}
-		return paths, nil
-	case jsonpath.NodeField:
-		field := node.(*jsonpath.FieldNode)
-		return []string{field.Value}, nil
-	default:
 		return nil, fmt.Errorf("unsupported node type %q found", node.Type())
 	}
+	return nodes, nil
 }
 
-func (mpt *metaPodTemplate) find(value, parent reflect.Value, keys []string, lastKey string, createIfNil bool) (reflect.Value, reflect.Value, string, error) {
-	if !value.IsValid() || value.IsNil() {
-		if !createIfNil {
+func (mpt *metaPodTemplate) find(value, parent reflect.Value, keys []jsonpath.Node, lastKey string, createIfNil bool) (reflect.Value, reflect.Value, string, error) {
+	if !value.IsValid() || isNil(value) {
+		if !createIfNil || !parent.IsValid() || parent.Kind() != reflect.Map {
 			return reflect.ValueOf(nil), reflect.ValueOf(nil), "", nil
 		}
+		for _, key := range keys {
+			if _, ok := key.(*jsonpath.FieldNode); !ok {
+				// array elements are never created
+				return reflect.ValueOf(nil), reflect.ValueOf(nil), "", nil
+			}
+		}
 		value = reflect.ValueOf(make(map[string]interface{}))
 		parent.SetMapIndex(reflect.ValueOf(lastKey), value)
 	}
@@ -254,11 +435,29 @@ func (mpt *metaPodTemplate) find(value, parent reflect.Value, keys []string, las
 	}
 	switch value.Kind() {
 	case reflect.Map:
-		lastKey = keys[0]
+		field, ok := keys[0].(*jsonpath.FieldNode)
+		if !ok {
+			// the workload does not have the expected structure
+			return reflect.ValueOf(nil), reflect.ValueOf(nil), "", nil
+		}
+		lastKey = field.Value
 		keys = keys[1:]
 		parent = value
 		value = value.MapIndex(reflect.ValueOf(lastKey))
 		return mpt.find(value, parent, keys, lastKey, createIfNil)
+	case reflect.Slice:
+		i, err := selectElement(value, keys[0])
+		if err != nil {
+			return reflect.ValueOf(nil), parent, lastKey, err
+		}
+		if i < 0 {
+			// no matching element
+			return reflect.ValueOf(nil), reflect.ValueOf(nil), "", nil
+		}
+		keys = keys[1:]
+		parent = value
+		value = value.Index(i)
+		return mpt.find(value, parent, keys, "", createIfNil)
 	case reflect.Interface:
 		parent = value
 		value = value.Elem()
@@ -267,3 +466,12 @@ func (mpt *metaPodTemplate) find(value, parent reflect.Value, keys []string, las
 		return reflect.ValueOf(nil), parent, lastKey, fmt.Errorf("unhandled kind %q", value.Kind())
 	}
 }
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Slice, reflect.Ptr:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
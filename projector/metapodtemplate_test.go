@@ -26,6 +26,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
@@ -95,29 +96,35 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: testAnnotations,
+				Labels:      map[string]string{},
 				Containers: []metaContainer{
 					{
 						Name:         pointer.String("init-hello"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 					{
 						Name:         pointer.String("init-hello-2"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 					{
 						Name:         pointer.String("hello"),
 						Env:          []corev1.EnvVar{testEnv},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
 					},
 					{
 						Name:         pointer.String("hello-2"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 				},
-				Volumes: []corev1.Volume{testVolume},
+				Volumes:          []corev1.Volume{testVolume},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -172,29 +179,35 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: testAnnotations,
+				Labels:      map[string]string{},
 				Containers: []metaContainer{
 					{
 						Name:         pointer.String("init-hello"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 					{
 						Name:         pointer.String("init-hello-2"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 					{
 						Name:         pointer.String("hello"),
 						Env:          []corev1.EnvVar{testEnv},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{testVolumeMount},
 					},
 					{
 						Name:         pointer.String("hello-2"),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 				},
-				Volumes: []corev1.Volume{testVolume},
+				Volumes:          []corev1.Volume{testVolume},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -202,9 +215,11 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
 			workload: &appsv1.Deployment{},
 			expected: &metaPodTemplate{
-				Annotations: map[string]string{},
-				Containers:  []metaContainer{},
-				Volumes:     []corev1.Volume{},
+				Annotations:      map[string]string{},
+				Labels:           map[string]string{},
+				Containers:       []metaContainer{},
+				Volumes:          []corev1.Volume{},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -223,14 +238,17 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: map[string]string{},
+				Labels:      map[string]string{},
 				Containers: []metaContainer{
 					{
 						Name:         pointer.String(""),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 				},
-				Volumes: []corev1.Volume{},
+				Volumes:          []corev1.Volume{},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -260,14 +278,17 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: map[string]string{},
+				Labels:      map[string]string{},
 				Containers: []metaContainer{
 					{
 						Name:         nil,
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 				},
-				Volumes: []corev1.Volume{},
+				Volumes:          []corev1.Volume{},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -306,10 +327,12 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: testAnnotations,
+				Labels:      map[string]string{},
 				Containers:  []metaContainer{},
 				Volumes: []corev1.Volume{
 					testVolume,
 				},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -349,14 +372,17 @@ func TestNewMetaPodTemplate(t *testing.T) {
 			},
 			expected: &metaPodTemplate{
 				Annotations: map[string]string{},
+				Labels:      map[string]string{},
 				Containers: []metaContainer{
 					{
 						Name:         pointer.String(""),
 						Env:          []corev1.EnvVar{},
+						EnvFrom:      []corev1.EnvFromSource{},
 						VolumeMounts: []corev1.VolumeMount{},
 					},
 				},
-				Volumes: []corev1.Volume{},
+				Volumes:          []corev1.Volume{},
+				ImagePullSecrets: []corev1.LocalObjectReference{},
 			},
 		},
 		{
@@ -711,3 +737,304 @@ func TestMetaPodTemplate_WriteToWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestMetaPodTemplate_JsonPath(t *testing.T) {
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Annotations: `.spec.components[?(@.kind=="app")].metadata.annotations`,
+		Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+			{
+				Path: ".spec.roles.*",
+				Name: ".name",
+			},
+			{
+				Path:         `.spec.components[?(@.kind=="app")].sidecars[-1]`,
+				Env:          ".config[0].env",
+				VolumeMounts: `.config[?(@.mounts)].mounts`,
+			},
+		},
+		Volumes: `.spec.components[?(@.kind=="app")].volumes`,
+	}
+	mapping.Default()
+
+	workload := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Custom",
+				"spec": map[string]interface{}{
+					"components": []interface{}{
+						map[string]interface{}{
+							"kind": "db",
+						},
+						map[string]interface{}{
+							"kind": "app",
+							"metadata": map[string]interface{}{
+								"annotations": map[string]interface{}{
+									"key": "value",
+								},
+							},
+							"sidecars": []interface{}{
+								map[string]interface{}{},
+								map[string]interface{}{
+									"config": []interface{}{
+										map[string]interface{}{},
+										map[string]interface{}{
+											"mounts": []interface{}{},
+										},
+									},
+								},
+							},
+						},
+					},
+					"roles": map[string]interface{}{
+						"worker": map[string]interface{}{
+							"name": "worker",
+							"env": []interface{}{
+								map[string]interface{}{
+									"name":  "NAME",
+									"value": "value",
+								},
+							},
+						},
+						"web": map[string]interface{}{
+							"name": "web",
+						},
+						"ignored": "not a container",
+					},
+				},
+			},
+		}
+	}
+
+	ctx := context.TODO()
+	actual, err := NewMetaPodTemplate(ctx, workload(), mapping)
+	if err != nil {
+		t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+	}
+	expected := &metaPodTemplate{
+		Annotations: map[string]string{
+			"key": "value",
+		},
+		Labels: map[string]string{},
+		Containers: []metaContainer{
+			{
+				Name:         pointer.String("web"),
+				Env:          []corev1.EnvVar{},
+				EnvFrom:      []corev1.EnvFromSource{},
+				VolumeMounts: []corev1.VolumeMount{},
+			},
+			{
+				Name: pointer.String("worker"),
+				Env: []corev1.EnvVar{
+					{
+						Name:  "NAME",
+						Value: "value",
+					},
+				},
+				EnvFrom:      []corev1.EnvFromSource{},
+				VolumeMounts: []corev1.VolumeMount{},
+			},
+			{
+				Env:          []corev1.EnvVar{},
+				EnvFrom:      []corev1.EnvFromSource{},
+				VolumeMounts: []corev1.VolumeMount{},
+			},
+		},
+		Volumes:          []corev1.Volume{},
+		ImagePullSecrets: []corev1.LocalObjectReference{},
+	}
+	if diff := cmp.Diff(expected, actual, cmpopts.IgnoreUnexported(metaPodTemplate{})); diff != "" {
+		t.Errorf("NewMetaPodTemplate() (-expected, +actual): %s", diff)
+	}
+
+	actual.Annotations["projected"] = "true"
+	for i := range actual.Containers {
+		actual.Containers[i].Env = append(actual.Containers[i].Env, corev1.EnvVar{Name: "PROJECTED", Value: "true"})
+		actual.Containers[i].VolumeMounts = append(actual.Containers[i].VolumeMounts, corev1.VolumeMount{Name: "projected", MountPath: "/projected"})
+	}
+	actual.Volumes = append(actual.Volumes, corev1.Volume{Name: "projected"})
+	if err := actual.WriteToWorkload(ctx); err != nil {
+		t.Fatalf("WriteToWorkload() unexpected err: %v", err)
+	}
+
+	projectedEnv := map[string]interface{}{
+		"name":  "PROJECTED",
+		"value": "true",
+	}
+	projectedVolumeMount := map[string]interface{}{
+		"name":      "projected",
+		"mountPath": "/projected",
+	}
+	expectedWorkload := workload()
+	spec := expectedWorkload.Object["spec"].(map[string]interface{})
+	app := spec["components"].([]interface{})[1].(map[string]interface{})
+	app["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})["projected"] = "true"
+	app["volumes"] = []interface{}{
+		map[string]interface{}{
+			"name": "projected",
+		},
+	}
+	sidecarConfig := app["sidecars"].([]interface{})[1].(map[string]interface{})["config"].([]interface{})
+	sidecarConfig[0].(map[string]interface{})["env"] = []interface{}{projectedEnv}
+	sidecarConfig[1].(map[string]interface{})["mounts"] = []interface{}{projectedVolumeMount}
+	roles := spec["roles"].(map[string]interface{})
+	web := roles["web"].(map[string]interface{})
+	web["env"] = []interface{}{projectedEnv}
+	web["volumeMounts"] = []interface{}{projectedVolumeMount}
+	worker := roles["worker"].(map[string]interface{})
+	worker["env"] = append(worker["env"].([]interface{}), projectedEnv)
+	worker["volumeMounts"] = []interface{}{projectedVolumeMount}
+
+	if diff := cmp.Diff(expectedWorkload, actual.workload); diff != "" {
+		t.Errorf("WriteToWorkload() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestMetaPodTemplate_JsonPathNotFound(t *testing.T) {
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Annotations: `.spec.components[?(@.kind=="app")].annotations`,
+		Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+			{
+				Path: `.spec.components[?(@.kind=="app")]`,
+			},
+		},
+		Volumes: ".spec.volumes[3].items",
+	}
+	mapping.Default()
+
+	workload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Custom",
+			"spec": map[string]interface{}{
+				"components": []interface{}{
+					map[string]interface{}{
+						"kind": "db",
+					},
+				},
+			},
+		},
+	}
+	expectedWorkload := workload.DeepCopy()
+
+	ctx := context.TODO()
+	actual, err := NewMetaPodTemplate(ctx, workload, mapping)
+	if err != nil {
+		t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+	}
+	expected := &metaPodTemplate{
+		Annotations:      map[string]string{},
+		Labels:           map[string]string{},
+		Containers:       []metaContainer{},
+		Volumes:          []corev1.Volume{},
+		ImagePullSecrets: []corev1.LocalObjectReference{},
+	}
+	if diff := cmp.Diff(expected, actual, cmpopts.IgnoreUnexported(metaPodTemplate{})); diff != "" {
+		t.Errorf("NewMetaPodTemplate() (-expected, +actual): %s", diff)
+	}
+
+	// locations that are not found are not created
+	actual.Annotations["projected"] = "true"
+	actual.Volumes = append(actual.Volumes, corev1.Volume{Name: "projected"})
+	if err := actual.WriteToWorkload(ctx); err != nil {
+		t.Fatalf("WriteToWorkload() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(expectedWorkload, workload); diff != "" {
+		t.Errorf("WriteToWorkload() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestMetaPodTemplate_ContainerFilterMatchesChange(t *testing.T) {
+	// the filter of the second container path matches components with env, which the first container path writes
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Annotations: ".metadata.annotations",
+		Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+			{
+				Path: `.spec.components[?(@.kind=="app")]`,
+				Name: ".name",
+			},
+			{
+				Path: `.spec.components[?(@.env)]`,
+				Name: ".name",
+			},
+		},
+		Volumes: ".spec.volumes",
+	}
+	mapping.Default()
+
+	workload := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Custom",
+			"spec": map[string]interface{}{
+				"components": []interface{}{
+					map[string]interface{}{
+						"kind": "app",
+						"name": "web",
+					},
+					map[string]interface{}{
+						"kind": "db",
+						"name": "store",
+						"env": []interface{}{
+							map[string]interface{}{
+								"name":  "NAME",
+								"value": "value",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.TODO()
+	actual, err := NewMetaPodTemplate(ctx, workload, mapping)
+	if err != nil {
+		t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff([]string{"web", "store"}, []string{*actual.Containers[0].Name, *actual.Containers[1].Name}); diff != "" {
+		t.Errorf("NewMetaPodTemplate() containers (-expected, +actual): %s", diff)
+	}
+
+	projectedEnv := corev1.EnvVar{Name: "PROJECTED", Value: "true"}
+	for i := range actual.Containers {
+		actual.Containers[i].Env = append(actual.Containers[i].Env, projectedEnv)
+	}
+	if err := actual.WriteToWorkload(ctx); err != nil {
+		t.Fatalf("WriteToWorkload() unexpected err: %v", err)
+	}
+
+	// only the containers matched when read are written, the web component now has env but was not matched by the
+	// second path
+	expectedComponents := []interface{}{
+		map[string]interface{}{
+			"kind": "app",
+			"name": "web",
+			"env": []interface{}{
+				map[string]interface{}{
+					"name":  "PROJECTED",
+					"value": "true",
+				},
+			},
+			"volumeMounts": []interface{}{},
+		},
+		map[string]interface{}{
+			"kind": "db",
+			"name": "store",
+			"env": []interface{}{
+				map[string]interface{}{
+					"name":  "NAME",
+					"value": "value",
+				},
+				map[string]interface{}{
+					"name":  "PROJECTED",
+					"value": "true",
+				},
+			},
+			"volumeMounts": []interface{}{},
+		},
+	}
+	if diff := cmp.Diff(expectedComponents, workload.Object["spec"].(map[string]interface{})["components"]); diff != "" {
+		t.Errorf("WriteToWorkload() (-expected, +actual): %s", diff)
+	}
+}
@@ -0,0 +1,460 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	jsonpatchv3 "gomodules.xyz/jsonpatch/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// Plan is a structured description of the changes a projection, or unprojection, makes to a workload. Only additions
+// and removals are described, reordering of existing items is not considered a change. An item whose content changes
+// is described as a removal of the old item and an addition of the new item.
+type Plan struct {
+	// Workload is a copy of the workload with the plan applied. The workload passed to the planner is not mutated.
+	Workload runtime.Object `json:"-"`
+	// original is the workload before the plan is applied
+	original runtime.Object
+
+	// PodTemplatePlan describes the changes to the workload's pod template when the mapping does not define pod
+	// templates.
+	PodTemplatePlan
+	// PodTemplates describes the changes to each named pod template that is changed, when the mapping defines pod
+	// templates.
+	PodTemplates []PodTemplatePlan `json:"podTemplates,omitempty"`
+}
+
+// PodTemplatePlan describes the changes made to a single pod template. The name is only set when the mapping defines
+// pod templates.
+type PodTemplatePlan struct {
+	Name                    string                        `json:"name,omitempty"`
+	AnnotationsSet          map[string]string             `json:"annotationsSet,omitempty"`
+	AnnotationsRemoved      []string                      `json:"annotationsRemoved,omitempty"`
+	LabelsSet               map[string]string             `json:"labelsSet,omitempty"`
+	LabelsRemoved           []string                      `json:"labelsRemoved,omitempty"`
+	VolumesAdded            []corev1.Volume               `json:"volumesAdded,omitempty"`
+	VolumesRemoved          []corev1.Volume               `json:"volumesRemoved,omitempty"`
+	ImagePullSecretsAdded   []corev1.LocalObjectReference `json:"imagePullSecretsAdded,omitempty"`
+	ImagePullSecretsRemoved []corev1.LocalObjectReference `json:"imagePullSecretsRemoved,omitempty"`
+	Containers              []ContainerPlan               `json:"containers,omitempty"`
+}
+
+// ContainerPlan describes the changes made to a single container. The index is the position of the container across
+// all container paths defined by the pod template's mapping, the name is only set when the mapping defines a name for
+// the container.
+type ContainerPlan struct {
+	Index               int                    `json:"index"`
+	Name                string                 `json:"name,omitempty"`
+	EnvAdded            []corev1.EnvVar        `json:"envAdded,omitempty"`
+	EnvRemoved          []corev1.EnvVar        `json:"envRemoved,omitempty"`
+	EnvFromAdded        []corev1.EnvFromSource `json:"envFromAdded,omitempty"`
+	EnvFromRemoved      []corev1.EnvFromSource `json:"envFromRemoved,omitempty"`
+	VolumeMountsAdded   []corev1.VolumeMount   `json:"volumeMountsAdded,omitempty"`
+	VolumeMountsRemoved []corev1.VolumeMount   `json:"volumeMountsRemoved,omitempty"`
+}
+
+func (p *serviceBindingProjector) PlanProject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error) {
+	mapping, err := p.mappingSource.LookupMapping(ctx, workload)
+	if err != nil {
+		return nil, err
+	}
+	projected := workload.DeepCopyObject()
+	mpts, err := NewMetaPodTemplates(ctx, projected, mapping)
+	if err != nil {
+		return nil, err
+	}
+	for _, mpt := range mpts {
+		p.projectPodTemplate(binding, mpt)
+	}
+	if err := writeMetaPodTemplates(ctx, mpts); err != nil {
+		return nil, err
+	}
+	return NewPlan(ctx, mapping, workload, projected)
+}
+
+func (p *serviceBindingProjector) PlanUnproject(ctx context.Context, binding *servicebindingv1beta1.ServiceBinding, workload runtime.Object) (*Plan, error) {
+	mapping, err := p.mappingSource.LookupMapping(ctx, workload)
+	if err != nil {
+		return nil, err
+	}
+	unprojected := workload.DeepCopyObject()
+	mpts, err := NewMetaPodTemplates(ctx, unprojected, mapping)
+	if err != nil {
+		return nil, err
+	}
+	for _, mpt := range mpts {
+		p.unproject(binding, mpt)
+	}
+	if err := writeMetaPodTemplates(ctx, mpts); err != nil {
+		return nil, err
+	}
+	return NewPlan(ctx, mapping, workload, unprojected)
+}
+
+func (p *serviceBindingProjector) PlanChanges(ctx context.Context, original, modified runtime.Object) (*Plan, error) {
+	mapping, err := p.mappingSource.LookupMapping(ctx, original)
+	if err != nil {
+		return nil, err
+	}
+	return NewPlan(ctx, mapping, original, modified)
+}
+
+// NewPlan describes the changes between two revisions of a workload, as seen through the mapping. Neither workload is
+// mutated.
+func NewPlan(ctx context.Context, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, original, modified runtime.Object) (*Plan, error) {
+	before, err := NewMetaPodTemplates(ctx, original.DeepCopyObject(), mapping)
+	if err != nil {
+		return nil, err
+	}
+	after, err := NewMetaPodTemplates(ctx, modified.DeepCopyObject(), mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		Workload: modified,
+		original: original,
+	}
+
+	for i := range after {
+		ptp, err := newPodTemplatePlan(before[i], after[i])
+		if err != nil {
+			return nil, err
+		}
+		if ptp.Name == "" {
+			plan.PodTemplatePlan = *ptp
+		} else if !ptp.isEmpty() {
+			plan.PodTemplates = append(plan.PodTemplates, *ptp)
+		}
+	}
+
+	return plan, nil
+}
+
+func newPodTemplatePlan(before, after *metaPodTemplate) (*PodTemplatePlan, error) {
+	if len(before.Containers) != len(after.Containers) {
+		return nil, fmt.Errorf("workloads must have the same number of containers, found %d and %d", len(before.Containers), len(after.Containers))
+	}
+
+	plan := &PodTemplatePlan{
+		Name: after.name,
+	}
+
+	plan.AnnotationsSet, plan.AnnotationsRemoved = diffMap(before.Annotations, after.Annotations)
+	plan.LabelsSet, plan.LabelsRemoved = diffMap(before.Labels, after.Labels)
+
+	for _, v := range after.Volumes {
+		if !containsVolume(before.Volumes, v) {
+			plan.VolumesAdded = append(plan.VolumesAdded, v)
+		}
+	}
+	for _, v := range before.Volumes {
+		if !containsVolume(after.Volumes, v) {
+			plan.VolumesRemoved = append(plan.VolumesRemoved, v)
+		}
+	}
+	for _, s := range after.ImagePullSecrets {
+		if !containsImagePullSecret(before.ImagePullSecrets, s) {
+			plan.ImagePullSecretsAdded = append(plan.ImagePullSecretsAdded, s)
+		}
+	}
+	for _, s := range before.ImagePullSecrets {
+		if !containsImagePullSecret(after.ImagePullSecrets, s) {
+			plan.ImagePullSecretsRemoved = append(plan.ImagePullSecretsRemoved, s)
+		}
+	}
+
+	for i := range after.Containers {
+		bc, ac := before.Containers[i], after.Containers[i]
+		cp := ContainerPlan{Index: i}
+		if ac.Name != nil {
+			cp.Name = *ac.Name
+		}
+		for _, e := range ac.Env {
+			if !containsEnv(bc.Env, e) {
+				cp.EnvAdded = append(cp.EnvAdded, e)
+			}
+		}
+		for _, e := range bc.Env {
+			if !containsEnv(ac.Env, e) {
+				cp.EnvRemoved = append(cp.EnvRemoved, e)
+			}
+		}
+		for _, e := range ac.EnvFrom {
+			if !containsEnvFrom(bc.EnvFrom, e) {
+				cp.EnvFromAdded = append(cp.EnvFromAdded, e)
+			}
+		}
+		for _, e := range bc.EnvFrom {
+			if !containsEnvFrom(ac.EnvFrom, e) {
+				cp.EnvFromRemoved = append(cp.EnvFromRemoved, e)
+			}
+		}
+		for _, m := range ac.VolumeMounts {
+			if !containsVolumeMount(bc.VolumeMounts, m) {
+				cp.VolumeMountsAdded = append(cp.VolumeMountsAdded, m)
+			}
+		}
+		for _, m := range bc.VolumeMounts {
+			if !containsVolumeMount(ac.VolumeMounts, m) {
+				cp.VolumeMountsRemoved = append(cp.VolumeMountsRemoved, m)
+			}
+		}
+		if !cp.isEmpty() {
+			plan.Containers = append(plan.Containers, cp)
+		}
+	}
+
+	return plan, nil
+}
+
+// IsEmpty returns true when the plan does not change the workload.
+func (p *Plan) IsEmpty() bool {
+	return p.PodTemplatePlan.isEmpty() && len(p.PodTemplates) == 0
+}
+
+func (ptp *PodTemplatePlan) isEmpty() bool {
+	return len(ptp.AnnotationsSet) == 0 && len(ptp.AnnotationsRemoved) == 0 &&
+		len(ptp.LabelsSet) == 0 && len(ptp.LabelsRemoved) == 0 &&
+		len(ptp.VolumesAdded) == 0 && len(ptp.VolumesRemoved) == 0 &&
+		len(ptp.ImagePullSecretsAdded) == 0 && len(ptp.ImagePullSecretsRemoved) == 0 &&
+		len(ptp.Containers) == 0
+}
+
+func (cp *ContainerPlan) isEmpty() bool {
+	return len(cp.EnvAdded) == 0 && len(cp.EnvRemoved) == 0 &&
+		len(cp.EnvFromAdded) == 0 && len(cp.EnvFromRemoved) == 0 &&
+		len(cp.VolumeMountsAdded) == 0 && len(cp.VolumeMountsRemoved) == 0
+}
+
+// Lines renders the plan as human-readable lines, one per change. Additions are prefixed with `+`, removals with `-`.
+func (p *Plan) Lines() []string {
+	lines := p.PodTemplatePlan.lines()
+	for i := range p.PodTemplates {
+		lines = append(lines, p.PodTemplates[i].lines()...)
+	}
+	return lines
+}
+
+// lines renders the pod template plan, changes within a named pod template are qualified by the pod template's name.
+func (ptp *PodTemplatePlan) lines() []string {
+	lines := []string{}
+	podTemplate := ""
+	if ptp.Name != "" {
+		podTemplate = fmt.Sprintf("podTemplate %s ", ptp.Name)
+	}
+
+	for _, k := range sortedKeys(ptp.AnnotationsSet) {
+		lines = append(lines, fmt.Sprintf("+ %sannotation %s=%q", podTemplate, k, ptp.AnnotationsSet[k]))
+	}
+	for _, k := range ptp.AnnotationsRemoved {
+		lines = append(lines, fmt.Sprintf("- %sannotation %s", podTemplate, k))
+	}
+	for _, k := range sortedKeys(ptp.LabelsSet) {
+		lines = append(lines, fmt.Sprintf("+ %slabel %s=%q", podTemplate, k, ptp.LabelsSet[k]))
+	}
+	for _, k := range ptp.LabelsRemoved {
+		lines = append(lines, fmt.Sprintf("- %slabel %s", podTemplate, k))
+	}
+	for _, v := range ptp.VolumesAdded {
+		lines = append(lines, fmt.Sprintf("+ %svolume %s", podTemplate, v.Name))
+	}
+	for _, v := range ptp.VolumesRemoved {
+		lines = append(lines, fmt.Sprintf("- %svolume %s", podTemplate, v.Name))
+	}
+	for _, s := range ptp.ImagePullSecretsAdded {
+		lines = append(lines, fmt.Sprintf("+ %simagePullSecret %s", podTemplate, s.Name))
+	}
+	for _, s := range ptp.ImagePullSecretsRemoved {
+		lines = append(lines, fmt.Sprintf("- %simagePullSecret %s", podTemplate, s.Name))
+	}
+	for _, cp := range ptp.Containers {
+		container := fmt.Sprintf("%scontainer[%d]", podTemplate, cp.Index)
+		if cp.Name != "" {
+			container = fmt.Sprintf("%scontainer %s", podTemplate, cp.Name)
+		}
+		for _, e := range cp.EnvAdded {
+			lines = append(lines, fmt.Sprintf("+ %s env %s%s", container, e.Name, envSource(e)))
+		}
+		for _, e := range cp.EnvRemoved {
+			lines = append(lines, fmt.Sprintf("- %s env %s%s", container, e.Name, envSource(e)))
+		}
+		for _, e := range cp.EnvFromAdded {
+			lines = append(lines, fmt.Sprintf("+ %s envFrom%s", container, envFromSource(e)))
+		}
+		for _, e := range cp.EnvFromRemoved {
+			lines = append(lines, fmt.Sprintf("- %s envFrom%s", container, envFromSource(e)))
+		}
+		for _, m := range cp.VolumeMountsAdded {
+			lines = append(lines, fmt.Sprintf("+ %s volumeMount %s at %s", container, m.Name, m.MountPath))
+		}
+		for _, m := range cp.VolumeMountsRemoved {
+			lines = append(lines, fmt.Sprintf("- %s volumeMount %s at %s", container, m.Name, m.MountPath))
+		}
+	}
+
+	return lines
+}
+
+// Diff renders the plan as a human-readable diff.
+func (p *Plan) Diff() string {
+	lines := p.Lines()
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// JSONPatch renders the plan as a JSON patch that transforms the original workload into the planned workload.
+func (p *Plan) JSONPatch() ([]jsonpatch.Operation, error) {
+	original, err := json.Marshal(p.original)
+	if err != nil {
+		return nil, err
+	}
+	modified, err := json.Marshal(p.Workload)
+	if err != nil {
+		return nil, err
+	}
+	// create patch using jsonpatch v3 since it preserves order, then convert back to v2 used by AdmissionResponse
+	patch, err := jsonpatchv3.CreatePatch(original, modified)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	operations := []jsonpatch.Operation{}
+	if err := json.Unmarshal(data, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+func envSource(e corev1.EnvVar) string {
+	switch {
+	case e.ValueFrom == nil:
+		// literal values may be sensitive, lines are logged and returned to users as warnings
+		return ""
+	case e.ValueFrom.SecretKeyRef != nil:
+		return fmt.Sprintf(" from secret %s key %s", e.ValueFrom.SecretKeyRef.Name, e.ValueFrom.SecretKeyRef.Key)
+	case e.ValueFrom.ConfigMapKeyRef != nil:
+		return fmt.Sprintf(" from configmap %s key %s", e.ValueFrom.ConfigMapKeyRef.Name, e.ValueFrom.ConfigMapKeyRef.Key)
+	case e.ValueFrom.FieldRef != nil:
+		return fmt.Sprintf(" from field %s", e.ValueFrom.FieldRef.FieldPath)
+	default:
+		return ""
+	}
+}
+
+func envFromSource(e corev1.EnvFromSource) string {
+	switch {
+	case e.SecretRef != nil:
+		return fmt.Sprintf(" secret %s", e.SecretRef.Name)
+	case e.ConfigMapRef != nil:
+		return fmt.Sprintf(" configmap %s", e.ConfigMapRef.Name)
+	default:
+		return ""
+	}
+}
+
+// diffMap returns the entries of the after map that are new or changed, and the sorted keys removed from the before
+// map.
+func diffMap(before, after map[string]string) (map[string]string, []string) {
+	var set map[string]string
+	var removed []string
+	for k, v := range after {
+		if ov, ok := before[k]; !ok || ov != v {
+			if set == nil {
+				set = map[string]string{}
+			}
+			set[k] = v
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return set, removed
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsVolume(volumes []corev1.Volume, volume corev1.Volume) bool {
+	for _, v := range volumes {
+		if equality.Semantic.DeepEqual(v, volume) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEnv(env []corev1.EnvVar, envVar corev1.EnvVar) bool {
+	for _, e := range env {
+		if equality.Semantic.DeepEqual(e, envVar) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEnvFrom(envFrom []corev1.EnvFromSource, source corev1.EnvFromSource) bool {
+	for _, e := range envFrom {
+		if equality.Semantic.DeepEqual(e, source) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsImagePullSecret(secrets []corev1.LocalObjectReference, secret corev1.LocalObjectReference) bool {
+	for _, s := range secrets {
+		if s == secret {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVolumeMount(mounts []corev1.VolumeMount, mount corev1.VolumeMount) bool {
+	for _, m := range mounts {
+		if equality.Semantic.DeepEqual(m, mount) {
+			return true
+		}
+	}
+	return false
+}
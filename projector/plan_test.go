@@ -0,0 +1,361 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gomodules.xyz/jsonpatch/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+func TestPlan(t *testing.T) {
+	uid := types.UID("26894874-4719-4802-8f43-8ceed127b4c2")
+	bindingName := "my-binding"
+	secretName := "my-secret"
+
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: uid,
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: bindingName,
+			Type: "my-type",
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "USERNAME",
+					Key:  "username",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: secretName,
+			},
+		},
+	}
+	unprojected := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+		},
+	}
+	projected := unprojected.DeepCopy()
+	if err := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})).Project(context.TODO(), binding, projected); err != nil {
+		t.Fatalf("unable to project workload: %s", err)
+	}
+
+	tests := []struct {
+		name          string
+		unproject     bool
+		binding       *servicebindingv1beta1.ServiceBinding
+		workload      runtime.Object
+		expected      *Plan
+		expectedLines []string
+		expectedPatch []jsonpatch.Operation
+		expectedErr   bool
+	}{
+		{
+			name:     "project",
+			binding:  binding,
+			workload: unprojected,
+			expected: &Plan{
+				PodTemplatePlan: PodTemplatePlan{
+					AnnotationsSet: map[string]string{
+						fmt.Sprintf("projector.servicebinding.io/secret-%s", uid): secretName,
+						fmt.Sprintf("projector.servicebinding.io/type-%s", uid):   "my-type",
+					},
+					VolumesAdded: projected.Spec.Template.Spec.Volumes,
+					Containers: []ContainerPlan{
+						{
+							Index:             0,
+							Name:              "app",
+							EnvAdded:          projected.Spec.Template.Spec.Containers[0].Env,
+							VolumeMountsAdded: projected.Spec.Template.Spec.Containers[0].VolumeMounts,
+						},
+					},
+				},
+			},
+			expectedLines: []string{
+				fmt.Sprintf(`+ annotation projector.servicebinding.io/secret-%s="my-secret"`, uid),
+				fmt.Sprintf(`+ annotation projector.servicebinding.io/type-%s="my-type"`, uid),
+				fmt.Sprintf("+ volume servicebinding-%s", uid),
+				"+ container app env SERVICE_BINDING_ROOT",
+				"+ container app env USERNAME from secret my-secret key username",
+				fmt.Sprintf("+ container app volumeMount servicebinding-%s at /bindings/my-binding", uid),
+			},
+			expectedPatch: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/spec/template/metadata/annotations",
+					Value: map[string]interface{}{
+						fmt.Sprintf("projector.servicebinding.io/secret-%s", uid): secretName,
+						fmt.Sprintf("projector.servicebinding.io/type-%s", uid):   "my-type",
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/volumes",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name": fmt.Sprintf("servicebinding-%s", uid),
+							"projected": map[string]interface{}{
+								"sources": []interface{}{
+									map[string]interface{}{
+										"secret": map[string]interface{}{
+											"name": secretName,
+										},
+									},
+									map[string]interface{}{
+										"downwardAPI": map[string]interface{}{
+											"items": []interface{}{
+												map[string]interface{}{
+													"path": "type",
+													"fieldRef": map[string]interface{}{
+														"fieldPath": fmt.Sprintf("metadata.annotations['projector.servicebinding.io/type-%s']", uid),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/containers/0/env",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name":  "SERVICE_BINDING_ROOT",
+							"value": "/bindings",
+						},
+						map[string]interface{}{
+							"name": "USERNAME",
+							"valueFrom": map[string]interface{}{
+								"secretKeyRef": map[string]interface{}{
+									"name": secretName,
+									"key":  "username",
+								},
+							},
+						},
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/template/spec/containers/0/volumeMounts",
+					Value: []interface{}{
+						map[string]interface{}{
+							"name":      fmt.Sprintf("servicebinding-%s", uid),
+							"readOnly":  true,
+							"mountPath": "/bindings/my-binding",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "project already projected",
+			binding:  binding,
+			workload: projected,
+			expected: &Plan{},
+		},
+		{
+			name:      "unproject",
+			unproject: true,
+			binding:   binding,
+			workload:  projected,
+			expected: &Plan{
+				PodTemplatePlan: PodTemplatePlan{
+					AnnotationsRemoved: []string{
+						fmt.Sprintf("projector.servicebinding.io/secret-%s", uid),
+						fmt.Sprintf("projector.servicebinding.io/type-%s", uid),
+					},
+					VolumesRemoved: projected.Spec.Template.Spec.Volumes,
+					Containers: []ContainerPlan{
+						{
+							Index:               0,
+							Name:                "app",
+							EnvRemoved:          projected.Spec.Template.Spec.Containers[0].Env[1:],
+							VolumeMountsRemoved: projected.Spec.Template.Spec.Containers[0].VolumeMounts,
+						},
+					},
+				},
+			},
+			expectedLines: []string{
+				fmt.Sprintf("- annotation projector.servicebinding.io/secret-%s", uid),
+				fmt.Sprintf("- annotation projector.servicebinding.io/type-%s", uid),
+				fmt.Sprintf("- volume servicebinding-%s", uid),
+				"- container app env USERNAME from secret my-secret key username",
+				fmt.Sprintf("- container app volumeMount servicebinding-%s at /bindings/my-binding", uid),
+			},
+		},
+		{
+			name:      "unproject not projected",
+			unproject: true,
+			binding:   binding,
+			workload:  unprojected,
+			expected:  &Plan{},
+		},
+		{
+			name:        "invalid workload",
+			binding:     binding,
+			workload:    &corev1.Pod{},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}
+			if _, ok := c.workload.(*corev1.Pod); ok {
+				// unsupported annotations path
+				mapping.Annotations = "[0]"
+			}
+			projector := New(NewStaticMapping(mapping))
+			workload := c.workload.DeepCopyObject()
+
+			var actual *Plan
+			var err error
+			if c.unproject {
+				actual, err = projector.PlanUnproject(context.TODO(), c.binding, workload)
+			} else {
+				actual, err = projector.PlanProject(context.TODO(), c.binding, workload)
+			}
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("PlanProject() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.workload, workload); diff != "" {
+				t.Errorf("PlanProject() mutated workload (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.expected, actual, cmp.AllowUnexported(Plan{}), cmp.FilterPath(func(p cmp.Path) bool {
+				// the workload and original are verified by the json patch
+				return p.String() == "Workload" || p.Last().String() == ".original"
+			}, cmp.Ignore())); diff != "" {
+				t.Errorf("PlanProject() (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(len(c.expectedLines) == 0, actual.IsEmpty()); diff != "" {
+				t.Errorf("IsEmpty() (-expected, +actual): %s", diff)
+			}
+			expectedLines := c.expectedLines
+			if expectedLines == nil {
+				expectedLines = []string{}
+			}
+			if diff := cmp.Diff(expectedLines, actual.Lines()); diff != "" {
+				t.Errorf("Lines() (-expected, +actual): %s", diff)
+			}
+			if c.expectedPatch != nil {
+				patch, err := actual.JSONPatch()
+				if err != nil {
+					t.Errorf("JSONPatch() unexpected err: %v", err)
+				}
+				if diff := cmp.Diff(c.expectedPatch, patch); diff != "" {
+					t.Errorf("JSONPatch() (-expected, +actual): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanChanges(t *testing.T) {
+	binding := func(uid types.UID, name string) *servicebindingv1beta1.ServiceBinding {
+		return &servicebindingv1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: uid,
+			},
+			Spec: servicebindingv1beta1.ServiceBindingSpec{
+				Name: name,
+			},
+			Status: servicebindingv1beta1.ServiceBindingStatus{
+				Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+					Name: name + "-secret",
+				},
+			},
+		}
+	}
+	bindings := []*servicebindingv1beta1.ServiceBinding{
+		binding("26894874-4719-4802-8f43-8ceed127b4c2", "my-binding"),
+		binding("ea4d8d8f-2a8e-4a0c-a8ac-7b3b9f1e45a1", "my-other-binding"),
+	}
+	original := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	projector := New(NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}))
+	modified := original.DeepCopy()
+	if err := projector.ProjectAll(context.TODO(), bindings, modified); err != nil {
+		t.Fatalf("unable to project workload: %s", err)
+	}
+	expected := original.DeepCopy()
+	modifiedCopy := modified.DeepCopy()
+
+	plan, err := projector.PlanChanges(context.TODO(), original, modified)
+	if err != nil {
+		t.Fatalf("PlanChanges() unexpected err: %v", err)
+	}
+	if diff := cmp.Diff(expected, original); diff != "" {
+		t.Errorf("PlanChanges() mutated original (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(modifiedCopy, modified); diff != "" {
+		t.Errorf("PlanChanges() mutated modified (-expected, +actual): %s", diff)
+	}
+
+	// the combined plan describes both bindings, with the shared service binding root set once
+	expectedLines := []string{
+		`+ annotation projector.servicebinding.io/secret-26894874-4719-4802-8f43-8ceed127b4c2="my-binding-secret"`,
+		`+ annotation projector.servicebinding.io/secret-ea4d8d8f-2a8e-4a0c-a8ac-7b3b9f1e45a1="my-other-binding-secret"`,
+		"+ volume servicebinding-26894874-4719-4802-8f43-8ceed127b4c2",
+		"+ volume servicebinding-ea4d8d8f-2a8e-4a0c-a8ac-7b3b9f1e45a1",
+		"+ container app env SERVICE_BINDING_ROOT",
+		"+ container app volumeMount servicebinding-26894874-4719-4802-8f43-8ceed127b4c2 at /bindings/my-binding",
+		"+ container app volumeMount servicebinding-ea4d8d8f-2a8e-4a0c-a8ac-7b3b9f1e45a1 at /bindings/my-other-binding",
+	}
+	if diff := cmp.Diff(expectedLines, plan.Lines()); diff != "" {
+		t.Errorf("Lines() (-expected, +actual): %s", diff)
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// typedMapping is the canonical mapping for a well-known workload type along with the order the mapping visits
+// container collections within the pod spec.
+type typedMapping struct {
+	mapping    *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+	containers []func(*corev1.PodSpec) []corev1.Container
+	// containerFields are the fields of the pod spec holding each container collection, in the same order
+	containerFields []string
+}
+
+func initContainers(ps *corev1.PodSpec) []corev1.Container { return ps.InitContainers }
+func containers(ps *corev1.PodSpec) []corev1.Container     { return ps.Containers }
+
+var podSpecableTypedMapping = func() typedMapping {
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{}
+	mapping.Default()
+	return typedMapping{
+		mapping:         mapping,
+		containers:      []func(*corev1.PodSpec) []corev1.Container{initContainers, containers},
+		containerFields: []string{"initContainers", "containers"},
+	}
+}()
+
+// cronJobTypedMapping mirrors the cronjobs.batch ClusterWorkloadResourceMapping installed with the runtime
+var cronJobTypedMapping = func() typedMapping {
+	mapping := &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+		Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+		Labels:      ".spec.jobTemplate.spec.template.metadata.labels",
+		Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+			{
+				Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+				Name: ".name",
+			},
+			{
+				Path: ".spec.jobTemplate.spec.template.spec.initContainers[*]",
+				Name: ".name",
+			},
+		},
+		Volumes:          ".spec.jobTemplate.spec.template.spec.volumes",
+		ImagePullSecrets: ".spec.jobTemplate.spec.template.spec.imagePullSecrets",
+	}
+	mapping.Default()
+	return typedMapping{
+		mapping:         mapping,
+		containers:      []func(*corev1.PodSpec) []corev1.Container{containers, initContainers},
+		containerFields: []string{"containers", "initContainers"},
+	}
+}()
+
+// typedPodTemplateSpec returns the PodTemplateSpec of a well-known workload type, avoiding conversion of the workload
+// to and from an unstructured form. The fast path is only taken when the mapping is the canonical mapping for the
+// type, any other mapping must be resolved reflectively.
+func typedPodTemplateSpec(workload runtime.Object, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) (*corev1.PodTemplateSpec, *typedMapping) {
+	var template *corev1.PodTemplateSpec
+	typed := &podSpecableTypedMapping
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		template = &w.Spec.Template
+	case *appsv1.StatefulSet:
+		template = &w.Spec.Template
+	case *appsv1.DaemonSet:
+		template = &w.Spec.Template
+	case *appsv1.ReplicaSet:
+		template = &w.Spec.Template
+	case *batchv1.Job:
+		template = &w.Spec.Template
+	case *batchv1.CronJob:
+		template = &w.Spec.JobTemplate.Spec.Template
+		typed = &cronJobTypedMapping
+	default:
+		return nil, nil
+	}
+	if !equalMappingTemplate(mapping, typed.mapping) {
+		return nil, nil
+	}
+	return template, typed
+}
+
+// unstructuredPodTemplateSpec returns the pod template of a well-known workload type held in an unstructured form, as
+// received by the admission webhook. Like typedPodTemplateSpec, the fast path is only taken when the mapping is the
+// canonical mapping for the type.
+func unstructuredPodTemplateSpec(workload runtime.Object, mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) (map[string]interface{}, *typedMapping) {
+	u, ok := workload.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	var fields []string
+	typed := &podSpecableTypedMapping
+	switch u.GroupVersionKind() {
+	case appsv1.SchemeGroupVersion.WithKind("Deployment"),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+		appsv1.SchemeGroupVersion.WithKind("DaemonSet"),
+		appsv1.SchemeGroupVersion.WithKind("ReplicaSet"),
+		batchv1.SchemeGroupVersion.WithKind("Job"):
+		fields = []string{"spec", "template"}
+	case batchv1.SchemeGroupVersion.WithKind("CronJob"):
+		fields = []string{"spec", "jobTemplate", "spec", "template"}
+		typed = &cronJobTypedMapping
+	default:
+		return nil, nil
+	}
+	if !equalMappingTemplate(mapping, typed.mapping) {
+		return nil, nil
+	}
+	// the template is mutated in place when written
+	template, _, _ := unstructured.NestedFieldNoCopy(u.Object, fields...)
+	if template, ok := template.(map[string]interface{}); ok {
+		return template, typed
+	}
+	// defer to the reflective path to report malformed workloads
+	return nil, nil
+}
+
+// equalMappingTemplate compares the paths of two mapping templates, ignoring the version.
+func equalMappingTemplate(a, b *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) bool {
+	if a.Annotations != b.Annotations || a.Labels != b.Labels || a.Volumes != b.Volumes ||
+		a.ImagePullSecrets != b.ImagePullSecrets || len(a.Containers) != len(b.Containers) || len(a.PodTemplates) != 0 {
+		return false
+	}
+	for i := range a.Containers {
+		if a.Containers[i] != b.Containers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (mpt *metaPodTemplate) readPodTemplateSpec(template *corev1.PodTemplateSpec, typed *typedMapping) {
+	for k, v := range template.Annotations {
+		mpt.Annotations[k] = v
+	}
+	for k, v := range template.Labels {
+		mpt.Labels[k] = v
+	}
+	for _, collection := range typed.containers {
+		for _, c := range collection(&template.Spec) {
+			mc := metaContainer{
+				Name:         pointer.String(c.Name),
+				Env:          make([]corev1.EnvVar, len(c.Env)),
+				EnvFrom:      make([]corev1.EnvFromSource, len(c.EnvFrom)),
+				VolumeMounts: make([]corev1.VolumeMount, len(c.VolumeMounts)),
+			}
+			for i := range c.Env {
+				c.Env[i].DeepCopyInto(&mc.Env[i])
+			}
+			for i := range c.EnvFrom {
+				c.EnvFrom[i].DeepCopyInto(&mc.EnvFrom[i])
+			}
+			for i := range c.VolumeMounts {
+				c.VolumeMounts[i].DeepCopyInto(&mc.VolumeMounts[i])
+			}
+			mpt.Containers = append(mpt.Containers, mc)
+		}
+	}
+	for i := range template.Spec.Volumes {
+		mpt.Volumes = append(mpt.Volumes, *template.Spec.Volumes[i].DeepCopy())
+	}
+	mpt.ImagePullSecrets = append(mpt.ImagePullSecrets, template.Spec.ImagePullSecrets...)
+}
+
+func (mpt *metaPodTemplate) readUnstructuredPodTemplateSpec(template map[string]interface{}, typed *typedMapping) error {
+	pts := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(template, pts); err != nil {
+		return err
+	}
+	mpt.readPodTemplateSpec(pts, typed)
+	return nil
+}
+
+// writeUnstructuredPodTemplateSpec writes the projected fields into the pod template, leaving the remainder of the
+// template untouched. Fields are written the same as the reflective path, required fields are always written while
+// optional fields are only written when they have a value or already exist.
+func (mpt *metaPodTemplate) writeUnstructuredPodTemplateSpec(template map[string]interface{}, typed *typedMapping) error {
+	if err := setUnstructuredField(template, &mpt.Annotations, false, "metadata", "annotations"); err != nil {
+		return err
+	}
+	if err := setUnstructuredField(template, &mpt.Labels, true, "metadata", "labels"); err != nil {
+		return err
+	}
+	ci := 0
+	for _, field := range typed.containerFields {
+		cs, _, _ := unstructured.NestedFieldNoCopy(template, "spec", field)
+		containers, _ := cs.([]interface{})
+		for i := 0; i < len(containers) && ci < len(mpt.Containers); i++ {
+			c, ok := containers[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			mc := &mpt.Containers[ci]
+			if mc.Name != nil {
+				c["name"] = *mc.Name
+			}
+			if err := setUnstructuredField(c, &mc.Env, false, "env"); err != nil {
+				return err
+			}
+			if err := setUnstructuredField(c, &mc.EnvFrom, true, "envFrom"); err != nil {
+				return err
+			}
+			if err := setUnstructuredField(c, &mc.VolumeMounts, false, "volumeMounts"); err != nil {
+				return err
+			}
+			ci++
+		}
+	}
+	if err := setUnstructuredField(template, &mpt.Volumes, false, "spec", "volumes"); err != nil {
+		return err
+	}
+	return setUnstructuredField(template, &mpt.ImagePullSecrets, true, "spec", "imagePullSecrets")
+}
+
+// setUnstructuredField sets the value at the fields of the object. An empty optional value is only written if the
+// field already exists.
+func setUnstructuredField(obj map[string]interface{}, value interface{}, optional bool, fields ...string) error {
+	v := reflect.ValueOf(value).Elem()
+	if optional && v.Len() == 0 {
+		if existing, ok, _ := unstructured.NestedFieldNoCopy(obj, fields...); !ok || existing == nil {
+			return nil
+		}
+	}
+
+	var out interface{}
+	switch v.Kind() {
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			m[k.String()] = v.MapIndex(k).Interface()
+		}
+		out = m
+	case reflect.Slice:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(v.Index(i).Addr().Interface())
+			if err != nil {
+				return err
+			}
+			items[i] = item
+		}
+		out = items
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := obj[field].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			obj[field] = next
+		}
+		obj = next
+	}
+	obj[fields[len(fields)-1]] = out
+	return nil
+}
+
+func (mpt *metaPodTemplate) writePodTemplateSpec(template *corev1.PodTemplateSpec, typed *typedMapping) {
+	template.Annotations = mpt.Annotations
+	if len(mpt.Labels) != 0 || template.Labels != nil {
+		// labels are optional
+		template.Labels = mpt.Labels
+	}
+	ci := 0
+	for _, collection := range typed.containers {
+		cs := collection(&template.Spec)
+		for i := 0; i < len(cs) && ci < len(mpt.Containers); i++ {
+			mc := mpt.Containers[ci]
+			if mc.Name != nil {
+				cs[i].Name = *mc.Name
+			}
+			cs[i].Env = mc.Env
+			if len(mc.EnvFrom) != 0 || cs[i].EnvFrom != nil {
+				// envFrom is optional
+				cs[i].EnvFrom = mc.EnvFrom
+			}
+			cs[i].VolumeMounts = mc.VolumeMounts
+			ci++
+		}
+	}
+	template.Spec.Volumes = mpt.Volumes
+	if len(mpt.ImagePullSecrets) != 0 || template.Spec.ImagePullSecrets != nil {
+		// image pull secrets are optional
+		template.Spec.ImagePullSecrets = mpt.ImagePullSecrets
+	}
+}
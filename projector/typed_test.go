@@ -0,0 +1,431 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+func TestTypedPodTemplateSpec(t *testing.T) {
+	podTemplateSpec := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"key": "value",
+			},
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name: "init",
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					Env: []corev1.EnvVar{
+						{
+							Name:  "NAME",
+							Value: "value",
+						},
+					},
+				},
+				{
+					Name: "sidecar",
+					EnvFrom: []corev1.EnvFromSource{
+						{
+							ConfigMapRef: &corev1.ConfigMapEnvSource{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "my-config",
+								},
+							},
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      "name",
+							MountPath: "/mount/path",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "name",
+				},
+			},
+			ImagePullSecrets: []corev1.LocalObjectReference{
+				{
+					Name: "my-registry",
+				},
+			},
+		},
+	}
+	cronJobMapping := cronJobTypedMapping.mapping.DeepCopy()
+	cronJobMapping.Version = "v1"
+
+	tests := []struct {
+		name     string
+		mapping  *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+		workload runtime.Object
+		typed    bool
+	}{
+		{
+			name:     "deployment",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: podTemplateSpec}},
+			typed:    true,
+		},
+		{
+			name:     "statefulset",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Template: podTemplateSpec}},
+			typed:    true,
+		},
+		{
+			name:     "daemonset",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.DaemonSet{Spec: appsv1.DaemonSetSpec{Template: podTemplateSpec}},
+			typed:    true,
+		},
+		{
+			name:     "replicaset",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.ReplicaSet{Spec: appsv1.ReplicaSetSpec{Template: podTemplateSpec}},
+			typed:    true,
+		},
+		{
+			name:     "job",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &batchv1.Job{Spec: batchv1.JobSpec{Template: podTemplateSpec}},
+			typed:    true,
+		},
+		{
+			name:     "cronjob",
+			mapping:  cronJobMapping,
+			workload: &batchv1.CronJob{Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: podTemplateSpec}}}},
+			typed:    true,
+		},
+		{
+			name:     "cronjob podspecable mapping",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &batchv1.CronJob{Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: podTemplateSpec}}}},
+			typed:    false,
+		},
+		{
+			name: "deployment custom mapping",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+			},
+			workload: &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: podTemplateSpec}},
+			typed:    false,
+		},
+		{
+			name:     "pod",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &corev1.Pod{Spec: podTemplateSpec.Spec},
+			typed:    false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			c.mapping.Default()
+
+			template, _ := typedPodTemplateSpec(c.workload, c.mapping)
+			if expected, actual := c.typed, template != nil; expected != actual {
+				t.Errorf("typedPodTemplateSpec() expected typed %v, actual %v", expected, actual)
+			}
+
+			// the typed and reflective paths must be indistinguishable
+			typed, err := NewMetaPodTemplate(ctx, c.workload.DeepCopyObject(), c.mapping)
+			if err != nil {
+				t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+			}
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c.workload)
+			if err != nil {
+				t.Fatalf("ToUnstructured() unexpected err: %v", err)
+			}
+			reflective, err := NewMetaPodTemplate(ctx, &unstructured.Unstructured{Object: u}, c.mapping)
+			if err != nil {
+				t.Fatalf("NewMetaPodTemplate() unexpected err: %v", err)
+			}
+			if diff := cmp.Diff(reflective, typed, cmp.AllowUnexported(metaPodTemplate{}), cmp.FilterPath(func(p cmp.Path) bool {
+				// only the reflective path holds the unstructured workload
+				last := p.Last().String()
+				return last == ".workload" || last == ".unstructured" || last == ".containerMatches"
+			}, cmp.Ignore())); diff != "" {
+				t.Errorf("NewMetaPodTemplate() (-reflective, +typed): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnstructuredPodTemplateSpec(t *testing.T) {
+	podTemplateSpec := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name:  "init",
+					Image: "scratch",
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Image: "scratch",
+					Env: []corev1.EnvVar{
+						{
+							Name:  "NAME",
+							Value: "value",
+						},
+					},
+				},
+			},
+		},
+	}
+	binding := &servicebindingv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			UID: "26894874-4719-4802-8f43-8ceed127b4c2",
+		},
+		Spec: servicebindingv1beta1.ServiceBindingSpec{
+			Name: "my-binding",
+			Env: []servicebindingv1beta1.EnvMapping{
+				{
+					Name: "USERNAME",
+					Key:  "username",
+				},
+			},
+		},
+		Status: servicebindingv1beta1.ServiceBindingStatus{
+			Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+				Name: "my-secret",
+			},
+		},
+	}
+	cronJobMapping := cronJobTypedMapping.mapping.DeepCopy()
+
+	tests := []struct {
+		name     string
+		mapping  *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+		workload runtime.Object
+		fast     bool
+	}{
+		{
+			name:     "deployment",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.Deployment{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}, Spec: appsv1.DeploymentSpec{Template: podTemplateSpec}},
+			fast:     true,
+		},
+		{
+			name:     "job",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &batchv1.Job{TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"}, Spec: batchv1.JobSpec{Template: podTemplateSpec}},
+			fast:     true,
+		},
+		{
+			name:     "cronjob",
+			mapping:  cronJobMapping,
+			workload: &batchv1.CronJob{TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"}, Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: podTemplateSpec}}}},
+			fast:     true,
+		},
+		{
+			name:     "unknown version",
+			mapping:  &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{},
+			workload: &appsv1.Deployment{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1beta2", Kind: "Deployment"}, Spec: appsv1.DeploymentSpec{Template: podTemplateSpec}},
+			fast:     false,
+		},
+		{
+			name: "custom mapping",
+			mapping: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+			},
+			workload: &appsv1.Deployment{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}, Spec: appsv1.DeploymentSpec{Template: podTemplateSpec}},
+			fast:     false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			c.mapping.Default()
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c.workload)
+			if err != nil {
+				t.Fatalf("ToUnstructured() unexpected err: %v", err)
+			}
+			workload := &unstructured.Unstructured{Object: u}
+			template, _ := unstructuredPodTemplateSpec(workload, c.mapping)
+			if expected, actual := c.fast, template != nil; expected != actual {
+				t.Errorf("unstructuredPodTemplateSpec() expected fast path %v, actual %v", expected, actual)
+			}
+
+			// the fast and reflective paths must be indistinguishable, the reflective path is taken for a kind that is
+			// not well-known
+			reflective := workload.DeepCopy()
+			reflective.SetAPIVersion("example.com/v1")
+			projector := New(NewStaticMapping(c.mapping))
+			if err := projector.Project(ctx, binding, workload); err != nil {
+				t.Fatalf("Project() unexpected err: %v", err)
+			}
+			if err := projector.Project(ctx, binding, reflective); err != nil {
+				t.Fatalf("Project() unexpected err: %v", err)
+			}
+			reflective.SetAPIVersion(workload.GetAPIVersion())
+			if diff := cmp.Diff(reflective, workload); diff != "" {
+				t.Errorf("Project() (-reflective, +fast): %s", diff)
+			}
+		})
+	}
+}
+
+func BenchmarkProject(b *testing.B) {
+	ctx := context.TODO()
+	mapping := NewStaticMapping(&servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{})
+	bindings := make([]*servicebindingv1beta1.ServiceBinding, 10)
+	for i := range bindings {
+		bindings[i] = &servicebindingv1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: types.UID(fmt.Sprintf("26894874-4719-4802-8f43-8ceed127b4c%d", i)),
+			},
+			Spec: servicebindingv1beta1.ServiceBindingSpec{
+				Name: fmt.Sprintf("my-binding-%d", i),
+				Type: "my-type",
+				Env: []servicebindingv1beta1.EnvMapping{
+					{
+						Name: fmt.Sprintf("USERNAME_%d", i),
+						Key:  "username",
+					},
+				},
+			},
+			Status: servicebindingv1beta1.ServiceBindingStatus{
+				Binding: &servicebindingv1beta1.ServiceBindingSecretReference{
+					Name: fmt.Sprintf("my-secret-%d", i),
+				},
+			},
+		}
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name:  "init",
+							Image: "scratch",
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:  "app",
+							Image: "scratch",
+						},
+						{
+							Name:  "sidecar",
+							Image: "scratch",
+						},
+					},
+				},
+			},
+		},
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	if err != nil {
+		b.Fatal(err)
+	}
+	unstructuredDeployment := &unstructured.Unstructured{Object: u}
+
+	b.Run("typed", func(b *testing.B) {
+		projector := New(mapping)
+		for n := 0; n < b.N; n++ {
+			workload := deployment.DeepCopy()
+			for _, binding := range bindings {
+				if err := projector.Project(ctx, binding, workload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("unstructured", func(b *testing.B) {
+		projector := New(mapping)
+		for n := 0; n < b.N; n++ {
+			workload := unstructuredDeployment.DeepCopy()
+			for _, binding := range bindings {
+				if err := projector.Project(ctx, binding, workload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("unstructured all", func(b *testing.B) {
+		projector := New(mapping)
+		for n := 0; n < b.N; n++ {
+			workload := unstructuredDeployment.DeepCopy()
+			if err := projector.ProjectAll(ctx, bindings, workload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("unstructured all baseline", func(b *testing.B) {
+		// a kind that is not well-known takes the reflective path, as unstructured workloads received by the admission
+		// webhook did before the fast path
+		baseline := unstructuredDeployment.DeepCopy()
+		baseline.SetAPIVersion("example.com/v1")
+		projector := New(mapping)
+		for n := 0; n < b.N; n++ {
+			workload := baseline.DeepCopy()
+			if err := projector.ProjectAll(ctx, bindings, workload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
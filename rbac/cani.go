@@ -27,9 +27,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// accessCheckerCacheSize bounds the number of reviews held by an AccessChecker
+const accessCheckerCacheSize = 1024
+
 type AccessChecker interface {
+	// CanI checks the controller is permitted to use the resource in all namespaces
 	CanI(ctx context.Context, group string, resource string) bool
+	// CanIInNamespace checks the controller is permitted to use the resource in the namespace
+	CanIInNamespace(ctx context.Context, namespace string, group string, resource string) bool
 	WithVerb(operation string) AccessChecker
+	// Invalidate discards the cached reviews of this checker, and every checker derived by WithVerb, so that a change
+	// in the permissions of the controller is observed by the next check
+	Invalidate()
+	// InvalidateNamespace discards the cached reviews for the namespace, as changes to the Roles and RoleBindings of a
+	// namespace do not affect access to other namespaces
+	InvalidateNamespace(namespace string)
 }
 
 func NewAccessChecker(client client.Client, ttl time.Duration) AccessChecker {
@@ -37,7 +49,9 @@ func NewAccessChecker(client client.Client, ttl time.Duration) AccessChecker {
 		client: client,
 		verb:   "*",
 		ttl:    ttl,
+		size:   accessCheckerCacheSize,
 		cache:  map[authorizationv1.ResourceAttributes]authorizationv1.SelfSubjectAccessReview{},
+		m:      &sync.Mutex{},
 	}
 }
 
@@ -45,8 +59,10 @@ type accessChecker struct {
 	client client.Client
 	verb   string
 	ttl    time.Duration
-	cache  map[authorizationv1.ResourceAttributes]authorizationv1.SelfSubjectAccessReview
-	m      sync.Mutex
+	size   int
+	// cache is shared with the checkers derived by WithVerb, the verb is part of the key
+	cache map[authorizationv1.ResourceAttributes]authorizationv1.SelfSubjectAccessReview
+	m     *sync.Mutex
 }
 
 func (ac *accessChecker) WithVerb(verb string) AccessChecker {
@@ -54,13 +70,39 @@ func (ac *accessChecker) WithVerb(verb string) AccessChecker {
 		client: ac.client,
 		verb:   verb,
 		ttl:    ac.ttl,
-		cache:  map[authorizationv1.ResourceAttributes]authorizationv1.SelfSubjectAccessReview{},
+		size:   ac.size,
+		cache:  ac.cache,
+		m:      ac.m,
+	}
+}
+
+func (ac *accessChecker) Invalidate() {
+	ac.m.Lock()
+	defer ac.m.Unlock()
+
+	for key := range ac.cache {
+		delete(ac.cache, key)
+	}
+}
+
+func (ac *accessChecker) InvalidateNamespace(namespace string) {
+	ac.m.Lock()
+	defer ac.m.Unlock()
+
+	for key := range ac.cache {
+		if key.Namespace == namespace {
+			delete(ac.cache, key)
+		}
 	}
 }
 
 func (ac *accessChecker) CanI(ctx context.Context, group string, resource string) bool {
+	return ac.CanIInNamespace(ctx, "", group, resource)
+}
+
+func (ac *accessChecker) CanIInNamespace(ctx context.Context, namespace string, group string, resource string) bool {
 	key := authorizationv1.ResourceAttributes{
-		Namespace: "",
+		Namespace: namespace,
 		Verb:      ac.verb,
 		Group:     group,
 		Resource:  resource,
@@ -83,10 +125,38 @@ func (ac *accessChecker) CanI(ctx context.Context, group string, resource string
 		ResourceAttributes: &key,
 	}
 	if err := ac.client.Create(ctx, &ssar); err != nil {
-		logr.FromContextOrDiscard(ctx).Error(err, "unable to check access", "resource", key)
 		// treat errors as not allowed
+		logr.FromContextOrDiscard(ctx).Error(err, "unable to check access", "resource", key)
+	}
+	if ssar.CreationTimestamp.IsZero() {
+		// reviews are not persisted by the API Server, record when the review was made
 		ssar.SetCreationTimestamp(metav1.Now())
 	}
+	ac.evict()
 	ac.cache[key] = ssar
 	return ssar.Status.Allowed
 }
+
+// evict makes room in the cache for another review. Stale reviews are dropped first, followed by the oldest review.
+// The lock must be held by the caller.
+func (ac *accessChecker) evict() {
+	if len(ac.cache) < ac.size {
+		return
+	}
+	var oldest *authorizationv1.ResourceAttributes
+	var oldestTimestamp metav1.Time
+	for key, ssar := range ac.cache {
+		if !ssar.CreationTimestamp.Add(ac.ttl).After(time.Now()) {
+			delete(ac.cache, key)
+			continue
+		}
+		if oldest == nil || ssar.CreationTimestamp.Before(&oldestTimestamp) {
+			key := key
+			oldest = &key
+			oldestTimestamp = ssar.CreationTimestamp
+		}
+	}
+	if len(ac.cache) >= ac.size && oldest != nil {
+		delete(ac.cache, *oldest)
+	}
+}
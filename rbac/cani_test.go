@@ -208,6 +208,152 @@ func TestAccessChecker(t *testing.T) {
 	})
 }
 
+func TestAccessCheckerInNamespace(t *testing.T) {
+	resource := &appsv1.Deployment{}
+	var ac *accessChecker
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	rts := rtesting.SubReconcilerTestSuite{{
+		Name:     "allow in namespace",
+		Resource: resource,
+		WithReactors: []rtesting.ReactionFunc{
+			allowSelfSubjectAccessReviewInNamespaceFor("my-namespace", "apps", "deployments", "get"),
+		},
+		ExpectCreates: []client.Object{
+			selfSubjectAccessReviewInNamespaceFor("my-namespace", "apps", "deployments", "get"),
+		},
+		CleanUp: func(t *testing.T) error {
+			if ssar, ok := ac.cache[authorizationv1.ResourceAttributes{
+				Namespace: "my-namespace",
+				Group:     "apps",
+				Resource:  "deployments",
+				Verb:      "get",
+			}]; !ok {
+				t.Errorf("review should be in cache")
+			} else if !ssar.Status.Allowed {
+				t.Errorf("cached review should be allowed")
+			}
+			return nil
+		},
+	}, {
+		Name:     "deny in another namespace",
+		Resource: resource,
+		WithReactors: []rtesting.ReactionFunc{
+			allowSelfSubjectAccessReviewInNamespaceFor("other-namespace", "apps", "deployments", "get"),
+		},
+		ExpectCreates: []client.Object{
+			selfSubjectAccessReviewInNamespaceFor("my-namespace", "apps", "deployments", "get"),
+		},
+		ShouldErr: true,
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		ac = NewAccessChecker(c, time.Hour).WithVerb("get").(*accessChecker)
+		return &reconcilers.SyncReconciler{
+			Sync: func(ctx context.Context, _ client.Object) error {
+				if !ac.CanIInNamespace(ctx, "my-namespace", "apps", "deployments") {
+					return fmt.Errorf("access denied")
+				}
+				return nil
+			},
+		}
+	})
+}
+
+func TestAccessCheckerInvalidate(t *testing.T) {
+	ac := NewAccessChecker(nil, time.Hour).(*accessChecker)
+	getter := ac.WithVerb("get").(*accessChecker)
+
+	for _, verb := range []string{"get", "update"} {
+		ac.cache[authorizationv1.ResourceAttributes{
+			Group:    "apps",
+			Resource: "deployments",
+			Verb:     verb,
+		}] = authorizationv1.SelfSubjectAccessReview{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.Now(),
+			},
+		}
+	}
+	if len(getter.cache) != 2 {
+		t.Errorf("expected the cache to be shared with derived checkers")
+	}
+
+	getter.Invalidate()
+	if len(ac.cache) != 0 {
+		t.Errorf("expected invalidation to clear the shared cache")
+	}
+}
+
+func TestAccessCheckerInvalidateNamespace(t *testing.T) {
+	ac := NewAccessChecker(nil, time.Hour).(*accessChecker)
+
+	for _, namespace := range []string{"", "my-namespace", "other-namespace"} {
+		ac.cache[authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Group:     "apps",
+			Resource:  "deployments",
+			Verb:      "get",
+		}] = authorizationv1.SelfSubjectAccessReview{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.Now(),
+			},
+		}
+	}
+
+	ac.WithVerb("update").InvalidateNamespace("my-namespace")
+	if len(ac.cache) != 2 {
+		t.Errorf("expected only the reviews for the namespace to be invalidated")
+	}
+	for key := range ac.cache {
+		if key.Namespace == "my-namespace" {
+			t.Errorf("expected the review for the namespace to be invalidated")
+		}
+	}
+}
+
+func TestAccessCheckerEvict(t *testing.T) {
+	ac := NewAccessChecker(nil, time.Hour).(*accessChecker)
+	ac.size = 2
+
+	review := func(age time.Duration) authorizationv1.SelfSubjectAccessReview {
+		return authorizationv1.SelfSubjectAccessReview{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+		}
+	}
+	older := authorizationv1.ResourceAttributes{Group: "apps", Resource: "deployments", Verb: "get"}
+	newer := authorizationv1.ResourceAttributes{Group: "apps", Resource: "statefulsets", Verb: "get"}
+	stale := authorizationv1.ResourceAttributes{Group: "apps", Resource: "daemonsets", Verb: "get"}
+
+	ac.cache[older] = review(time.Minute)
+	ac.evict()
+	if len(ac.cache) != 1 {
+		t.Errorf("expected room in the cache, nothing evicted")
+	}
+
+	ac.cache[newer] = review(time.Second)
+	ac.evict()
+	if _, ok := ac.cache[older]; ok {
+		t.Errorf("expected the oldest review to be evicted")
+	}
+	if _, ok := ac.cache[newer]; !ok {
+		t.Errorf("expected the newer review to be retained")
+	}
+
+	ac.cache[stale] = review(2 * time.Hour)
+	ac.evict()
+	if _, ok := ac.cache[stale]; ok {
+		t.Errorf("expected the stale review to be evicted")
+	}
+	if _, ok := ac.cache[newer]; !ok {
+		t.Errorf("expected the newer review to be retained")
+	}
+}
+
 func selfSubjectAccessReviewFor(group, resource, verb string) *authorizationv1.SelfSubjectAccessReview {
 	return &authorizationv1.SelfSubjectAccessReview{
 		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
@@ -237,3 +383,21 @@ func allowSelfSubjectAccessReviewFor(group, resource, verb string) rtesting.Reac
 		return false, nil, nil
 	}
 }
+
+func selfSubjectAccessReviewInNamespaceFor(namespace, group, resource, verb string) *authorizationv1.SelfSubjectAccessReview {
+	ssar := selfSubjectAccessReviewFor(group, resource, verb)
+	ssar.Spec.ResourceAttributes.Namespace = namespace
+	return ssar
+}
+
+func allowSelfSubjectAccessReviewInNamespaceFor(namespace, group, resource, verb string) rtesting.ReactionFunc {
+	allow := allowSelfSubjectAccessReviewFor(group, resource, verb)
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		if create, ok := action.(rtesting.CreateAction); ok {
+			if ssar, ok := create.GetObject().(*authorizationv1.SelfSubjectAccessReview); ok && ssar.Spec.ResourceAttributes != nil && ssar.Spec.ResourceAttributes.Namespace != namespace {
+				return false, nil, nil
+			}
+		}
+		return allow(action)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+// AggregationLabel selects the ClusterRoles that are aggregated into the role of the controller
+const AggregationLabel = "servicebinding.io/controller"
+
+var (
+	// ServiceVerbs are the verbs the controller uses to resolve a service
+	ServiceVerbs = []string{"get", "list", "watch"}
+	// WorkloadVerbs are the verbs the controller uses to project a binding into a workload
+	WorkloadVerbs = []string{"get", "list", "watch", "update", "patch"}
+)
+
+// Requirements are the verbs the controller needs for each resource
+type Requirements map[schema.GroupResource]sets.String
+
+// Require records that the controller needs the verbs for the resource
+func (r Requirements) Require(gr schema.GroupResource, verbs ...string) {
+	if _, ok := r[gr]; !ok {
+		r[gr] = sets.NewString()
+	}
+	r[gr].Insert(verbs...)
+}
+
+// MissingRules checks the access of the controller to each resource, and returns rules granting the verbs the
+// controller is not permitted to use. Resources of the same group that are missing the same verbs share a rule.
+func (r Requirements) MissingRules(ctx context.Context, accessChecker AccessChecker) []rbacv1.PolicyRule {
+	type groupVerbs struct {
+		group string
+		verbs string
+	}
+	resources := map[groupVerbs]sets.String{}
+	for gr, verbs := range r {
+		missing := MissingVerbs(ctx, accessChecker, gr, verbs.List())
+		if len(missing) == 0 {
+			continue
+		}
+		key := groupVerbs{group: gr.Group, verbs: strings.Join(missing, ",")}
+		if _, ok := resources[key]; !ok {
+			resources[key] = sets.NewString()
+		}
+		resources[key].Insert(gr.Resource)
+	}
+
+	rules := make([]rbacv1.PolicyRule, 0, len(resources))
+	for key, names := range resources {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{key.group},
+			Resources: names.List(),
+			Verbs:     strings.Split(key.verbs, ","),
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].APIGroups[0] != rules[j].APIGroups[0] {
+			return rules[i].APIGroups[0] < rules[j].APIGroups[0]
+		}
+		return rules[i].Resources[0] < rules[j].Resources[0]
+	})
+	return rules
+}
+
+// MissingVerbs returns the verbs the controller is not permitted to use on the resource in all namespaces. Verbs are
+// returned in the order of WorkloadVerbs, followed by any other verbs in alphabetical order.
+func MissingVerbs(ctx context.Context, accessChecker AccessChecker, gr schema.GroupResource, verbs []string) []string {
+	remaining := sets.NewString(verbs...)
+	ordered := []string{}
+	for _, verb := range WorkloadVerbs {
+		if remaining.Has(verb) {
+			ordered = append(ordered, verb)
+			remaining.Delete(verb)
+		}
+	}
+	ordered = append(ordered, remaining.List()...)
+
+	missing := []string{}
+	for _, verb := range ordered {
+		if !accessChecker.WithVerb(verb).CanI(ctx, gr.Group, gr.Resource) {
+			missing = append(missing, verb)
+		}
+	}
+	return missing
+}
+
+// AggregatedClusterRole creates a ClusterRole with the rules that is aggregated into the role of the controller
+func AggregatedClusterRole(name string, rules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				AggregationLabel: "true",
+			},
+		},
+		Rules: rules,
+	}
+}
+
+// Diagnosis is the outcome of checking the access of the controller to the services and workloads it manages
+type Diagnosis struct {
+	// Rules grant the permissions the controller is missing, empty when the controller has the access it needs
+	Rules []rbacv1.PolicyRule
+	// Notes describe resources that were not able to be checked
+	Notes []string
+}
+
+// Diagnose scans every ServiceBinding and ClusterWorkloadResourceMapping for the services and workloads the
+// controller manages, and checks the controller is permitted to use each resource
+func Diagnose(ctx context.Context, c client.Client, accessChecker AccessChecker) (*Diagnosis, error) {
+	diagnosis := &Diagnosis{}
+	requirements := Requirements{}
+
+	require := func(apiVersion, kind string, verbs []string, source string) {
+		gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+		rm, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			diagnosis.Notes = append(diagnosis.Notes, fmt.Sprintf("unable to resolve the resource for %s of %s: %s", gvk.GroupKind(), source, err))
+			return
+		}
+		requirements.Require(rm.Resource.GroupResource(), verbs...)
+	}
+
+	serviceBindings := &servicebindingv1beta1.ServiceBindingList{}
+	if err := c.List(ctx, serviceBindings); err != nil {
+		return nil, err
+	}
+	for i := range serviceBindings.Items {
+		serviceBinding := &serviceBindings.Items[i]
+		source := fmt.Sprintf("ServiceBinding %s", client.ObjectKeyFromObject(serviceBinding))
+		services := append([]servicebindingv1beta1.ServiceBindingServiceReference{serviceBinding.Spec.Service}, serviceBinding.Spec.FallbackServices...)
+		for _, additional := range serviceBinding.Spec.AdditionalServices {
+			services = append(services, additional.ServiceBindingServiceReference)
+		}
+		for _, service := range services {
+			require(service.APIVersion, service.Kind, ServiceVerbs, source)
+		}
+		require(serviceBinding.Spec.Workload.APIVersion, serviceBinding.Spec.Workload.Kind, WorkloadVerbs, source)
+	}
+
+	mappings := &servicebindingv1beta1.ClusterWorkloadResourceMappingList{}
+	if err := c.List(ctx, mappings); err != nil {
+		return nil, err
+	}
+	for i := range mappings.Items {
+		// the name of a mapping is the {resource}.{group} of the workload
+		requirements.Require(schema.ParseGroupResource(mappings.Items[i].Name), WorkloadVerbs...)
+	}
+
+	diagnosis.Rules = requirements.MissingRules(ctx, accessChecker)
+	return diagnosis, nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+)
+
+func TestMissingVerbs(t *testing.T) {
+	accessChecker := &stubAccessChecker{allowed: sets.NewString("apps/deployments/get", "apps/deployments/watch")}
+
+	actual := MissingVerbs(context.TODO(), accessChecker, schema.GroupResource{Group: "apps", Resource: "deployments"}, []string{"watch", "escalate", "get", "patch", "list", "bind", "update"})
+	expected := []string{"list", "update", "patch", "bind", "escalate"}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("MissingVerbs() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestRequirementsMissingRules(t *testing.T) {
+	accessChecker := &stubAccessChecker{allowed: sets.NewString("/secrets/get", "/secrets/list", "/secrets/watch", "apps/replicasets/get")}
+
+	requirements := Requirements{}
+	requirements.Require(schema.GroupResource{Resource: "secrets"}, ServiceVerbs...)
+	requirements.Require(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, WorkloadVerbs...)
+	requirements.Require(schema.GroupResource{Group: "apps", Resource: "deployments"}, "get", "list")
+	requirements.Require(schema.GroupResource{Group: "apps", Resource: "deployments"}, WorkloadVerbs...)
+	requirements.Require(schema.GroupResource{Group: "apps", Resource: "replicasets"}, ServiceVerbs...)
+
+	actual := requirements.MissingRules(context.TODO(), accessChecker)
+	expected := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "statefulsets"},
+			Verbs:     []string{"get", "list", "watch", "update", "patch"},
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"replicasets"},
+			Verbs:     []string{"list", "watch"},
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("MissingRules() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestAggregatedClusterRole(t *testing.T) {
+	rules := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments"},
+			Verbs:     WorkloadVerbs,
+		},
+	}
+
+	actual := AggregatedClusterRole("my-role", rules)
+	expected := &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-role",
+			Labels: map[string]string{
+				"servicebinding.io/controller": "true",
+			},
+		},
+		Rules: rules,
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("AggregatedClusterRole() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	restMapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(restMapper).
+		WithRuntimeObjects(
+			&servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Service: servicebindingv1beta1.ServiceBindingServiceReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "my-secret",
+					},
+					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			&servicebindingv1beta1.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-unknown-binding",
+				},
+				Spec: servicebindingv1beta1.ServiceBindingSpec{
+					Service: servicebindingv1beta1.ServiceBindingServiceReference{
+						APIVersion: "example.com/v1",
+						Kind:       "MyService",
+						Name:       "my-service",
+					},
+					Workload: servicebindingv1beta1.ServiceBindingWorkloadReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-workload",
+					},
+				},
+			},
+			&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cronjobs.batch",
+				},
+			},
+		).
+		Build()
+	accessChecker := &stubAccessChecker{allowed: sets.NewString("/secrets/get", "/secrets/list", "/secrets/watch")}
+
+	actual, err := Diagnose(context.TODO(), c, accessChecker)
+	if err != nil {
+		t.Fatalf("Diagnose() unexpected error: %s", err)
+	}
+	expected := &Diagnosis{
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"get", "list", "watch", "update", "patch"},
+			},
+			{
+				APIGroups: []string{"batch"},
+				Resources: []string{"cronjobs"},
+				Verbs:     []string{"get", "list", "watch", "update", "patch"},
+			},
+		},
+		Notes: []string{
+			`unable to resolve the resource for MyService.example.com of ServiceBinding my-namespace/my-unknown-binding: no matches for kind "MyService" in version "example.com/v1"`,
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("Diagnose() (-expected, +actual): %s", diff)
+	}
+}
+
+// stubAccessChecker allows the {group}/{resource}/{verb} combinations in the allowed set
+type stubAccessChecker struct {
+	verb    string
+	allowed sets.String
+}
+
+func (ac *stubAccessChecker) CanI(ctx context.Context, group, resource string) bool {
+	return ac.allowed.Has(group + "/" + resource + "/" + ac.verb)
+}
+
+func (ac *stubAccessChecker) CanIInNamespace(ctx context.Context, namespace, group, resource string) bool {
+	return ac.CanI(ctx, group, resource)
+}
+
+func (ac *stubAccessChecker) WithVerb(verb string) AccessChecker {
+	return &stubAccessChecker{verb: verb, allowed: ac.allowed}
+}
+
+func (ac *stubAccessChecker) Invalidate() {}
+
+func (ac *stubAccessChecker) InvalidateNamespace(namespace string) {}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// subjectAccessCheckerCacheSize bounds the number of reviews held by a SubjectAccessChecker
+const subjectAccessCheckerCacheSize = 1024
+
+// SubjectAccessChecker checks the access of a user other than the controller, like the author of a ServiceBinding
+type SubjectAccessChecker interface {
+	// Can checks the user is permitted to use the resource. An error is returned when the access is not able to be
+	// reviewed, the outcome is not cached so that the review is retried.
+	Can(ctx context.Context, user authenticationv1.UserInfo, attributes authorizationv1.ResourceAttributes) (bool, error)
+}
+
+func NewSubjectAccessChecker(client client.Client, ttl time.Duration) SubjectAccessChecker {
+	return &subjectAccessChecker{
+		client: client,
+		ttl:    ttl,
+		size:   subjectAccessCheckerCacheSize,
+		cache:  map[subjectAccessKey]authorizationv1.SubjectAccessReview{},
+	}
+}
+
+type subjectAccessKey struct {
+	user       string
+	attributes authorizationv1.ResourceAttributes
+}
+
+type subjectAccessChecker struct {
+	client client.Client
+	ttl    time.Duration
+	size   int
+	cache  map[subjectAccessKey]authorizationv1.SubjectAccessReview
+	m      sync.Mutex
+}
+
+func (sc *subjectAccessChecker) Can(ctx context.Context, user authenticationv1.UserInfo, attributes authorizationv1.ResourceAttributes) (bool, error) {
+	// a UserInfo is always able to be marshaled
+	u, _ := json.Marshal(user)
+	key := subjectAccessKey{
+		user:       string(u),
+		attributes: attributes,
+	}
+
+	sc.m.Lock()
+	defer sc.m.Unlock()
+
+	sar, ok := sc.cache[key]
+	if ok {
+		// ensure cached value is sufficiently recent
+		if sar.GetCreationTimestamp().Add(sc.ttl).After(time.Now()) {
+			return sar.Status.Allowed, nil
+		}
+		delete(sc.cache, key)
+		sar = authorizationv1.SubjectAccessReview{}
+	}
+
+	var extra map[string]authorizationv1.ExtraValue
+	if user.Extra != nil {
+		extra = make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+		for k, v := range user.Extra {
+			extra[k] = authorizationv1.ExtraValue(v)
+		}
+	}
+	sar.Spec = authorizationv1.SubjectAccessReviewSpec{
+		ResourceAttributes: &attributes,
+		User:               user.Username,
+		Groups:             user.Groups,
+		Extra:              extra,
+		UID:                user.UID,
+	}
+	if err := sc.client.Create(ctx, &sar); err != nil {
+		// not cached, the review is retried by the next check
+		return false, err
+	}
+	if sar.CreationTimestamp.IsZero() {
+		// reviews are not persisted by the API Server, record when the review was made
+		sar.SetCreationTimestamp(metav1.Now())
+	}
+	sc.evict()
+	sc.cache[key] = sar
+	return sar.Status.Allowed, nil
+}
+
+// evict makes room in the cache for another review. Stale reviews are dropped first, followed by the oldest review.
+// The lock must be held by the caller.
+func (sc *subjectAccessChecker) evict() {
+	if len(sc.cache) < sc.size {
+		return
+	}
+	var oldest *subjectAccessKey
+	var oldestTimestamp metav1.Time
+	for key, sar := range sc.cache {
+		if !sar.CreationTimestamp.Add(sc.ttl).After(time.Now()) {
+			delete(sc.cache, key)
+			continue
+		}
+		if oldest == nil || sar.CreationTimestamp.Before(&oldestTimestamp) {
+			key := key
+			oldest = &key
+			oldestTimestamp = sar.CreationTimestamp
+		}
+	}
+	if len(sc.cache) >= sc.size && oldest != nil {
+		delete(sc.cache, *oldest)
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSubjectAccessChecker(t *testing.T) {
+	resource := &appsv1.Deployment{}
+	var sc *subjectAccessChecker
+
+	user := authenticationv1.UserInfo{
+		Username: "alice",
+		Groups:   []string{"system:authenticated"},
+	}
+	attributes := authorizationv1.ResourceAttributes{
+		Namespace: "my-namespace",
+		Group:     "apps",
+		Resource:  "deployments",
+		Verb:      "update",
+		Name:      "my-workload",
+	}
+	key := subjectAccessKey{
+		user:       `{"username":"alice","groups":["system:authenticated"]}`,
+		attributes: attributes,
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	rts := rtesting.SubReconcilerTestSuite{{
+		Name:     "allow, added to cache",
+		Resource: resource,
+		WithReactors: []rtesting.ReactionFunc{
+			reviewSubjectAccessReviews(),
+			allowSubjectAccessReviewFor("alice", attributes),
+		},
+		ExpectCreates: []client.Object{
+			subjectAccessReviewFor(user, attributes),
+		},
+		CleanUp: func(t *testing.T) error {
+			if len(sc.cache) != 1 {
+				t.Errorf("unexpected cache")
+			}
+			if sar, ok := sc.cache[key]; !ok {
+				t.Errorf("review should be in cache")
+			} else if !sar.Status.Allowed {
+				t.Errorf("cached review should be allowed")
+			}
+			return nil
+		},
+	}, {
+		Name:     "allow, from cache",
+		Resource: resource,
+		Prepare: func(t *testing.T) error {
+			sc.cache[key] = authorizationv1.SubjectAccessReview{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.Now(),
+				},
+				Status: authorizationv1.SubjectAccessReviewStatus{
+					Allowed: true,
+				},
+			}
+			return nil
+		},
+	}, {
+		Name:     "deny, added to cache",
+		Resource: resource,
+		WithReactors: []rtesting.ReactionFunc{
+			reviewSubjectAccessReviews(),
+		},
+		ExpectCreates: []client.Object{
+			subjectAccessReviewFor(user, attributes),
+		},
+		CleanUp: func(t *testing.T) error {
+			if len(sc.cache) != 1 {
+				t.Errorf("unexpected cache")
+			}
+			if sar, ok := sc.cache[key]; !ok {
+				t.Errorf("review should be in cache")
+			} else if sar.Status.Allowed {
+				t.Errorf("cached review should be denied")
+			}
+			return nil
+		},
+		ShouldErr: true,
+	}, {
+		Name:     "deny, from cache",
+		Resource: resource,
+		Prepare: func(t *testing.T) error {
+			sc.cache[key] = authorizationv1.SubjectAccessReview{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.Now(),
+				},
+				Status: authorizationv1.SubjectAccessReviewStatus{
+					Allowed: false,
+				},
+			}
+			return nil
+		},
+		ShouldErr: true,
+	}, {
+		Name:     "deny, another user",
+		Resource: resource,
+		Prepare: func(t *testing.T) error {
+			sc.cache[subjectAccessKey{
+				user:       `{"username":"bob"}`,
+				attributes: attributes,
+			}] = authorizationv1.SubjectAccessReview{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.Now(),
+				},
+				Status: authorizationv1.SubjectAccessReviewStatus{
+					Allowed: true,
+				},
+			}
+			return nil
+		},
+		WithReactors: []rtesting.ReactionFunc{
+			reviewSubjectAccessReviews(),
+		},
+		ExpectCreates: []client.Object{
+			subjectAccessReviewFor(user, attributes),
+		},
+		CleanUp: func(t *testing.T) error {
+			if len(sc.cache) != 2 {
+				t.Errorf("unexpected cache")
+			}
+			return nil
+		},
+		ShouldErr: true,
+	}, {
+		Name:     "refresh stale cache",
+		Resource: resource,
+		Prepare: func(t *testing.T) error {
+			sc.cache[key] = authorizationv1.SubjectAccessReview{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				},
+				Status: authorizationv1.SubjectAccessReviewStatus{
+					Allowed: false,
+				},
+			}
+			return nil
+		},
+		WithReactors: []rtesting.ReactionFunc{
+			reviewSubjectAccessReviews(),
+			allowSubjectAccessReviewFor("alice", attributes),
+		},
+		ExpectCreates: []client.Object{
+			subjectAccessReviewFor(user, attributes),
+		},
+		CleanUp: func(t *testing.T) error {
+			if sar, ok := sc.cache[key]; !ok {
+				t.Errorf("review should be in cache")
+			} else if !sar.Status.Allowed {
+				t.Errorf("cached review should be allowed")
+			}
+			return nil
+		},
+	}, {
+		Name:     "review failed, not cached",
+		Resource: resource,
+		WithReactors: []rtesting.ReactionFunc{
+			rtesting.InduceFailure("create", "SubjectAccessReview"),
+		},
+		ExpectCreates: []client.Object{
+			subjectAccessReviewFor(user, attributes),
+		},
+		CleanUp: func(t *testing.T) error {
+			if len(sc.cache) != 0 {
+				t.Errorf("failed review should not be cached")
+			}
+			return nil
+		},
+		ShouldErr: true,
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase, c reconcilers.Config) reconcilers.SubReconciler {
+		sc = NewSubjectAccessChecker(c, time.Hour).(*subjectAccessChecker)
+		return &reconcilers.SyncReconciler{
+			Sync: func(ctx context.Context, _ client.Object) error {
+				allowed, err := sc.Can(ctx, user, attributes)
+				if err != nil {
+					return err
+				}
+				if !allowed {
+					return fmt.Errorf("access denied")
+				}
+				return nil
+			},
+		}
+	})
+}
+
+func subjectAccessReviewFor(user authenticationv1.UserInfo, attributes authorizationv1.ResourceAttributes) *authorizationv1.SubjectAccessReview {
+	return &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+		},
+	}
+}
+
+// reviewSubjectAccessReviews names the SubjectAccessReviews being created, which the fake client requires in order to
+// accept the review. Reviews are not persisted by the API Server, so their name is otherwise not meaningful.
+func reviewSubjectAccessReviews() rtesting.ReactionFunc {
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		r := action.GetResource()
+		if r.Group != "authorization.k8s.io" || r.Resource != "SubjectAccessReview" || r.Version != "v1" || action.GetVerb() != "create" {
+			// ignore, not creating a SubjectAccessReview
+			return false, nil, nil
+		}
+		sar := action.(rtesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.GenerateName = "review-"
+		return false, nil, nil
+	}
+}
+
+func allowSubjectAccessReviewFor(username string, attributes authorizationv1.ResourceAttributes) rtesting.ReactionFunc {
+	return func(action rtesting.Action) (handled bool, ret runtime.Object, err error) {
+		r := action.GetResource()
+		if r.Group != "authorization.k8s.io" || r.Resource != "SubjectAccessReview" || r.Version != "v1" || action.GetVerb() != "create" {
+			// ignore, not creating a SubjectAccessReview
+			return false, nil, nil
+		}
+		sar := action.(rtesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		if ra := sar.Spec.ResourceAttributes; ra != nil && sar.Spec.User == username && *ra == attributes {
+			sar.Status.Allowed = true
+			return true, sar, nil
+		}
+		return false, nil, nil
+	}
+}
+
+func TestSubjectAccessCheckerEvict(t *testing.T) {
+	sc := NewSubjectAccessChecker(nil, time.Hour).(*subjectAccessChecker)
+	sc.size = 2
+
+	review := func(age time.Duration) authorizationv1.SubjectAccessReview {
+		return authorizationv1.SubjectAccessReview{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+		}
+	}
+	older := subjectAccessKey{user: `{"username":"alice"}`}
+	newer := subjectAccessKey{user: `{"username":"bob"}`}
+	stale := subjectAccessKey{user: `{"username":"carol"}`}
+
+	sc.cache[older] = review(time.Minute)
+	sc.evict()
+	if len(sc.cache) != 1 {
+		t.Errorf("expected room in the cache, nothing evicted")
+	}
+
+	sc.cache[newer] = review(time.Second)
+	sc.evict()
+	if _, ok := sc.cache[older]; ok {
+		t.Errorf("expected the oldest review to be evicted")
+	}
+	if _, ok := sc.cache[newer]; !ok {
+		t.Errorf("expected the newer review to be retained")
+	}
+
+	sc.cache[stale] = review(2 * time.Hour)
+	sc.evict()
+	if _, ok := sc.cache[stale]; ok {
+		t.Errorf("expected the stale review to be evicted")
+	}
+	if _, ok := sc.cache[newer]; !ok {
+		t.Errorf("expected the newer review to be retained")
+	}
+}
@@ -19,14 +19,17 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
@@ -37,11 +40,17 @@ import (
 func New(config reconcilers.Config) Resolver {
 	return &clusterResolver{
 		config: config,
+		mappingSources: []MappingSource{
+			&namespacedMappingSource{config: config},
+			&clusterMappingSource{config: config},
+			&catalogMappingSource{},
+		},
 	}
 }
 
 type clusterResolver struct {
-	config reconcilers.Config
+	config         reconcilers.Config
+	mappingSources []MappingSource
 }
 
 func (m *clusterResolver) LookupMapping(ctx context.Context, workload runtime.Object) (*servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, error) {
@@ -53,28 +62,30 @@ func (m *clusterResolver) LookupMapping(ctx context.Context, workload runtime.Ob
 	if err != nil {
 		return nil, err
 	}
-	wrm := &servicebindingv1beta1.ClusterWorkloadResourceMapping{}
-	err = m.config.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s.%s", rm.Resource.Resource, rm.Resource.Group)}, wrm)
-	if err != nil {
-		if !apierrs.IsNotFound(err) {
+	name := fmt.Sprintf("%s.%s", rm.Resource.Resource, rm.Resource.Group)
+	namespace := ""
+	if obj, ok := workload.(metav1.Object); ok {
+		namespace = obj.GetNamespace()
+	}
+
+	// the first source to define a mapping for the resource is used
+	spec := &servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{}
+	for _, source := range m.mappingSources {
+		found, err := source.LookupMappingSpec(ctx, namespace, name)
+		if err != nil {
 			return nil, err
 		}
+		if found != nil {
+			spec = found
+			break
+		}
 	}
 
 	// find version mapping
-	wildcardMapping := servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*"}
-	var mapping *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
-	for _, v := range wrm.Spec.Versions {
-		switch v.Version {
-		case gvk.Version:
-			mapping = &v
-		case "*":
-			wildcardMapping = v
-		}
-	}
+	mapping := spec.LookupVersion(gvk.Version)
 	if mapping == nil {
 		// use wildcard version by default
-		mapping = &wildcardMapping
+		mapping = &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*"}
 	}
 
 	mapping = mapping.DeepCopy()
@@ -88,18 +99,171 @@ func (r *clusterResolver) LookupBindingSecret(ctx context.Context, serviceRef co
 		// direct secret reference
 		return serviceRef.Name, nil
 	}
-	service := &unstructured.Unstructured{}
-	service.SetAPIVersion(serviceRef.APIVersion)
-	service.SetKind(serviceRef.Kind)
-	if err := r.config.TrackAndGet(ctx, client.ObjectKey{Namespace: serviceRef.Namespace, Name: serviceRef.Name}, service); err != nil {
+	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "ConfigMap" {
+		// direct config map reference, there is no secret
+		return "", nil
+	}
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return "", err
+	}
+	mapping, err := r.lookupServiceMapping(ctx, service)
+	if err != nil {
 		return "", err
 	}
+	if mapping != nil {
+		if mapping.SecretName == "" {
+			// the binding secret is synthesized from the service, see LookupBindingSecretData
+			return "", nil
+		}
+		return lookupSecretName(service, mapping.SecretName)
+	}
 	secretName, exists, err := unstructured.NestedString(service.UnstructuredContent(), "status", "binding", "name")
 	// treat missing values as empty
 	_ = exists
 	return secretName, err
 }
 
+func (r *clusterResolver) LookupBindingSecretData(ctx context.Context, serviceRef corev1.ObjectReference) (map[string][]byte, error) {
+	if serviceRef.APIVersion == "v1" && (serviceRef.Kind == "Secret" || serviceRef.Kind == "ConfigMap") {
+		// direct secret or config map reference
+		return nil, nil
+	}
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := r.lookupServiceMapping(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	if mapping == nil || len(mapping.Data) == 0 {
+		return nil, nil
+	}
+	data := map[string][]byte{}
+	for _, entry := range mapping.Data {
+		value, err := servicebindingv1beta1.ExecuteTemplate(entry.Key, entry.Value, service.UnstructuredContent())
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %q: %s", ErrBindingSecretTemplate, entry.Key, err)
+		}
+		data[entry.Key] = value
+	}
+	return data, nil
+}
+
+func (r *clusterResolver) LookupBindingConfigMap(ctx context.Context, serviceRef corev1.ObjectReference) (string, error) {
+	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "ConfigMap" {
+		// direct config map reference
+		return serviceRef.Name, nil
+	}
+	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "Secret" {
+		// direct secret reference, there is no config map
+		return "", nil
+	}
+	service, err := r.lookupService(ctx, serviceRef)
+	if err != nil {
+		return "", err
+	}
+	configMapName, exists, err := unstructured.NestedString(service.UnstructuredContent(), "status", "configMap", "name")
+	// treat missing values as empty
+	_ = exists
+	return configMapName, err
+}
+
+func (r *clusterResolver) LookupServices(ctx context.Context, serviceRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]corev1.ObjectReference, error) {
+	if serviceRef.Name != "" {
+		return []corev1.ObjectReference{serviceRef}, nil
+	}
+	services := &unstructured.UnstructuredList{}
+	services.SetAPIVersion(serviceRef.APIVersion)
+	services.SetKind(fmt.Sprintf("%sList", serviceRef.Kind))
+	ls, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	reader := client.Reader(r.config)
+	if serviceRef.APIVersion == "v1" && serviceRef.Kind == "Secret" {
+		// only the secrets owned by ServiceBindings are cached
+		reader = r.config.APIReader
+	}
+	// TODO TrackAndList
+	if err := reader.List(ctx, services, client.InNamespace(serviceRef.Namespace), client.MatchingLabelsSelector{Selector: ls}); err != nil {
+		return nil, err
+	}
+
+	items := services.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		ti := items[i].GetCreationTimestamp()
+		tj := items[j].GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+	refs := make([]corev1.ObjectReference, len(items))
+	for i := range items {
+		refs[i] = corev1.ObjectReference{
+			APIVersion: serviceRef.APIVersion,
+			Kind:       serviceRef.Kind,
+			Namespace:  serviceRef.Namespace,
+			Name:       items[i].GetName(),
+		}
+	}
+	return refs, nil
+}
+
+func (r *clusterResolver) lookupService(ctx context.Context, serviceRef corev1.ObjectReference) (*unstructured.Unstructured, error) {
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion(serviceRef.APIVersion)
+	service.SetKind(serviceRef.Kind)
+	if err := r.config.TrackAndGet(ctx, client.ObjectKey{Namespace: serviceRef.Namespace, Name: serviceRef.Name}, service); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// lookupServiceMapping returns the ClusterServiceResourceMapping template for the version of the service, or nil if
+// the service's resource is not mapped
+func (r *clusterResolver) lookupServiceMapping(ctx context.Context, service *unstructured.Unstructured) (*servicebindingv1beta1.ClusterServiceResourceMappingTemplate, error) {
+	gvk := service.GroupVersionKind()
+	rm, err := r.config.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// fall back to the duck type
+			return nil, nil
+		}
+		return nil, err
+	}
+	csrm := &servicebindingv1beta1.ClusterServiceResourceMapping{}
+	if err := r.config.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s.%s", rm.Resource.Resource, rm.Resource.Group)}, csrm); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return csrm.Spec.LookupVersion(gvk.Version), nil
+}
+
+// lookupSecretName resolves the secret name from the service, missing values are treated as empty
+func lookupSecretName(service *unstructured.Unstructured, path string) (string, error) {
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", path)); err != nil {
+		return "", err
+	}
+	results, err := jp.FindResults(service.UnstructuredContent())
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return "", nil
+	}
+	secretName, ok := results[0][0].Interface().(string)
+	if !ok {
+		return "", fmt.Errorf("secret name at %q must be a string", path)
+	}
+	return secretName, nil
+}
+
 func (r *clusterResolver) LookupWorkloads(ctx context.Context, workloadRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]runtime.Object, error) {
 	if workloadRef.Name != "" {
 		workload, err := r.lookupWorkload(ctx, workloadRef)
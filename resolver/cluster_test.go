@@ -18,7 +18,9 @@ package resolver_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/vmware-labs/reconciler-runtime/reconcilers"
@@ -47,6 +49,11 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 	utilruntime.Must(batchv1.AddToScheme(scheme))
 	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
 
+	defaulted := func(template servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate) *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate {
+		template.Default()
+		return &template
+	}
+
 	tests := []struct {
 		name         string
 		givenObjects []client.Object
@@ -61,21 +68,25 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 			expected: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
 				Version:     "*",
 				Annotations: ".spec.template.metadata.annotations",
+				Labels:      ".spec.template.metadata.labels",
 				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
 					{
 						Path:         ".spec.template.spec.initContainers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 					{
 						Path:         ".spec.template.spec.containers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 				},
-				Volumes: ".spec.template.spec.volumes",
+				Volumes:          ".spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
 			},
 		},
 		{
@@ -116,12 +127,14 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 					{
 						Path:         ".spec.jobTemplate.spec.template.spec.containers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 				},
 				Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
@@ -165,12 +178,14 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 					{
 						Path:         ".spec.jobTemplate.spec.template.spec.containers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 				},
 				Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
@@ -210,22 +225,245 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 				// default PodSpecable mapping, it won't actually work for a CronJob,
 				// but absent an explicit mapping, this is what's required.
 				Annotations: ".spec.template.metadata.annotations",
+				Labels:      ".spec.template.metadata.labels",
 				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
 					{
 						Path:         ".spec.template.spec.initContainers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
 					},
 					{
 						Path:         ".spec.template.spec.containers[*]",
 						Name:         ".name",
 						Env:          ".env",
 						VolumeMounts: ".volumeMounts",
+						EnvFrom:      ".envFrom",
+					},
+				},
+				Volumes:          ".spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.template.spec.imagePullSecrets",
+			},
+		},
+		{
+			name: "exact version is preferred over ranges, globs and wildcards",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "v*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+							{Version: ">=v1beta1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[2]"},
+							{Version: "v1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[3]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "v1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[3]"}),
+		},
+		{
+			name: "range is preferred over globs and wildcards",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "v1*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+							{Version: ">=v1beta1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[2]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: ">=v1beta1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[2]"}),
+		},
+		{
+			name: "glob is preferred over wildcards",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "v?", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "v?", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"}),
+		},
+		{
+			name: "range lower bound is ordered by version priority",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: ">v1beta2", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: ">v1beta2", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"}),
+		},
+		{
+			name: "wildcard is used when range excludes the version",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "<v1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"}),
+		},
+		{
+			name: "default mapping is used when no pattern matches",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "v1beta*", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[0]"},
+							{Version: "<=v1beta1", Annotations: ".spec.jobTemplate.spec.template.metadata.annotations[1]"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*"}),
+		},
+		{
+			name:         "catalog mapping is used when the cluster does not define a mapping",
+			givenObjects: []client.Object{},
+			workload:     &batchv1.CronJob{},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "*",
+				Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+				Labels:      ".spec.jobTemplate.spec.template.metadata.labels",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+						Name: ".name",
+					},
+					{
+						Path: ".spec.jobTemplate.spec.template.spec.initContainers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes:          ".spec.jobTemplate.spec.template.spec.volumes",
+				ImagePullSecrets: ".spec.jobTemplate.spec.template.spec.imagePullSecrets",
+			}),
+		},
+		{
+			name: "namespaced mapping is preferred over cluster mapping",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.cluster"},
+						},
+					},
+				},
+				&servicebindingv1beta1.WorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.namespaced"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+			},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*", Annotations: ".spec.namespaced"}),
+		},
+		{
+			name: "namespaced mapping in another namespace is ignored",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.ClusterWorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.cluster"},
+						},
+					},
+				},
+				&servicebindingv1beta1.WorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "other-namespace",
+						Name:      "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.namespaced"},
+						},
+					},
+				},
+			},
+			workload: &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+			},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*", Annotations: ".spec.cluster"}),
+		},
+		{
+			name: "namespaced mapping without a cluster mapping",
+			givenObjects: []client.Object{
+				&servicebindingv1beta1.WorkloadResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "cronjobs.batch",
+					},
+					Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+							{Version: "*", Annotations: ".spec.namespaced"},
+						},
 					},
 				},
-				Volumes: ".spec.template.spec.volumes",
 			},
+			workload: &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+				},
+			},
+			expected: defaulted(servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{Version: "*", Annotations: ".spec.namespaced"}),
 		},
 		{
 			name: "error if workload type not found in scheme",
@@ -304,6 +542,34 @@ func TestClusterResolver_LookupMapping(t *testing.T) {
 func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	mappedService := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "service.local/v1",
+			"kind":       "MappedService",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-service",
+			},
+			"spec": map[string]interface{}{
+				"writeConnectionSecretToRef": map[string]interface{}{
+					"name": "my-connection-secret",
+				},
+			},
+			"status": map[string]interface{}{
+				"binding": map[string]interface{}{
+					"name": "my-secret",
+				},
+			},
+		},
+	}
+	mappedServiceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "MappedService",
+		Namespace:  "my-namespace",
+		Name:       "my-service",
+	}
 
 	tests := []struct {
 		name         string
@@ -323,6 +589,17 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 			},
 			expected: "my-secret",
 		},
+		{
+			name:         "direct config map binding",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  "my-namespace",
+				Name:       "my-config-map",
+			},
+			expected: "",
+		},
 		{
 			name: "found provisioned service",
 			givenObjects: []client.Object{
@@ -373,6 +650,78 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 			},
 			expected: "",
 		},
+		{
+			name: "mapped secret name",
+			givenObjects: []client.Object{
+				mappedService,
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{Version: "*", SecretName: ".spec.writeConnectionSecretToRef.name"},
+						},
+					},
+				},
+			},
+			serviceRef: mappedServiceRef,
+			expected:   "my-connection-secret",
+		},
+		{
+			name: "mapped secret name is missing",
+			givenObjects: []client.Object{
+				mappedService,
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{Version: "*", SecretName: ".spec.missing.name"},
+						},
+					},
+				},
+			},
+			serviceRef: mappedServiceRef,
+			expected:   "",
+		},
+		{
+			name: "mapped synthesized secret has no name",
+			givenObjects: []client.Object{
+				mappedService,
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{Version: "*", Data: []servicebindingv1beta1.ClusterServiceResourceMappingData{{Key: "type", Value: "mysql"}}},
+						},
+					},
+				},
+			},
+			serviceRef: mappedServiceRef,
+			expected:   "",
+		},
+		{
+			name: "unmapped version uses the provisioned service duck type",
+			givenObjects: []client.Object{
+				mappedService,
+				&servicebindingv1beta1.ClusterServiceResourceMapping{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "mappedservices.service.local",
+					},
+					Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+						Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+							{Version: "v2", SecretName: ".spec.writeConnectionSecretToRef.name"},
+						},
+					},
+				},
+			},
+			serviceRef: mappedServiceRef,
+			expected:   "my-secret",
+		},
 		{
 			name:         "not found",
 			givenObjects: []client.Object{},
@@ -394,6 +743,8 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
 				Tracker: tracker.New(0),
 			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "MappedService"}, meta.RESTScopeNamespace)
 			resolver := resolver.New(config)
 
 			actual, err := resolver.LookupBindingSecret(ctx, c.serviceRef)
@@ -411,6 +762,399 @@ func TestClusterResolver_LookupBindingSecret(t *testing.T) {
 	}
 }
 
+func TestClusterResolver_LookupBindingSecretData(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	mappedService := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "service.local/v1",
+			"kind":       "MappedService",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-service",
+			},
+			"status": map[string]interface{}{
+				"host": "mysql.example.com",
+				"port": int64(3306),
+			},
+		},
+	}
+	mappedServiceRef := corev1.ObjectReference{
+		APIVersion: "service.local/v1",
+		Kind:       "MappedService",
+		Namespace:  "my-namespace",
+		Name:       "my-service",
+	}
+	mapping := func(data ...servicebindingv1beta1.ClusterServiceResourceMappingData) *servicebindingv1beta1.ClusterServiceResourceMapping {
+		return &servicebindingv1beta1.ClusterServiceResourceMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "mappedservices.service.local",
+			},
+			Spec: servicebindingv1beta1.ClusterServiceResourceMappingSpec{
+				Versions: []servicebindingv1beta1.ClusterServiceResourceMappingTemplate{
+					{Version: "*", Data: data},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		givenObjects     []client.Object
+		serviceRef       corev1.ObjectReference
+		expected         map[string][]byte
+		expectedErr      bool
+		expectedTemplate bool
+	}{
+		{
+			name:         "direct binding",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-secret",
+			},
+			expected: nil,
+		},
+		{
+			name:         "unmapped service",
+			givenObjects: []client.Object{mappedService},
+			serviceRef:   mappedServiceRef,
+			expected:     nil,
+		},
+		{
+			name: "synthesized",
+			givenObjects: []client.Object{
+				mappedService,
+				mapping(
+					servicebindingv1beta1.ClusterServiceResourceMappingData{Key: "type", Value: "mysql"},
+					servicebindingv1beta1.ClusterServiceResourceMappingData{Key: "host", Value: "{{ .status.host }}"},
+					servicebindingv1beta1.ClusterServiceResourceMappingData{Key: "uri", Value: "mysql://{{ .status.host }}:{{ .status.port }}"},
+				),
+			},
+			serviceRef: mappedServiceRef,
+			expected: map[string][]byte{
+				"type": []byte("mysql"),
+				"host": []byte("mysql.example.com"),
+				"uri":  []byte("mysql://mysql.example.com:3306"),
+			},
+		},
+		{
+			name: "missing field",
+			givenObjects: []client.Object{
+				mappedService,
+				mapping(
+					servicebindingv1beta1.ClusterServiceResourceMappingData{Key: "password", Value: "{{ .status.password }}"},
+				),
+			},
+			serviceRef:       mappedServiceRef,
+			expectedErr:      true,
+			expectedTemplate: true,
+		},
+		{
+			name: "oversized output",
+			givenObjects: []client.Object{
+				mappedService,
+				mapping(
+					servicebindingv1beta1.ClusterServiceResourceMappingData{Key: "host", Value: "{{ range 20000000 }}{{ $.status.host }}{{ end }}"},
+				),
+			},
+			serviceRef:       mappedServiceRef,
+			expectedErr:      true,
+			expectedTemplate: true,
+		},
+		{
+			name:         "not found",
+			givenObjects: []client.Object{},
+			serviceRef:   mappedServiceRef,
+			expectedErr:  true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			restMapper := config.RESTMapper().(*meta.DefaultRESTMapper)
+			restMapper.Add(schema.GroupVersionKind{Group: "service.local", Version: "v1", Kind: "MappedService"}, meta.RESTScopeNamespace)
+			r := resolver.New(config)
+
+			actual, err := r.LookupBindingSecretData(ctx, c.serviceRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupBindingSecretData() expected err: %v", err)
+			}
+			if errors.Is(err, resolver.ErrBindingSecretTemplate) != c.expectedTemplate {
+				t.Errorf("LookupBindingSecretData() expected template err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupBindingSecretData() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupBindingConfigMap(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(servicebindingv1beta1.AddToScheme(scheme))
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		serviceRef   corev1.ObjectReference
+		expected     string
+		expectedErr  bool
+	}{
+		{
+			name:         "direct binding",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  "my-namespace",
+				Name:       "my-config-map",
+			},
+			expected: "my-config-map",
+		},
+		{
+			name:         "direct secret binding",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-secret",
+			},
+			expected: "",
+		},
+		{
+			name: "found provisioned service with config map",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "ProvisionedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "my-secret",
+							},
+							"configMap": map[string]interface{}{
+								"name": "my-config-map",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: "my-config-map",
+		},
+		{
+			name: "found provisioned service without config map",
+			givenObjects: []client.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "service.local/v1",
+						"kind":       "ProvisionedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "my-secret",
+							},
+						},
+					},
+				},
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: "",
+		},
+		{
+			name:         "not found",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "service.local/v1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				Client:  rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker: tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupBindingConfigMap(ctx, c.serviceRef)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupBindingConfigMap() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupBindingConfigMap() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResolver_LookupServices(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	service := func(name string, created metav1.Time, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "my-namespace",
+				Name:              name,
+				CreationTimestamp: created,
+				Labels:            labels,
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		givenObjects []client.Object
+		serviceRef   corev1.ObjectReference
+		selector     *metav1.LabelSelector
+		expected     []corev1.ObjectReference
+		expectedErr  bool
+	}{
+		{
+			name:         "named service",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: []corev1.ObjectReference{
+				{APIVersion: "v1", Kind: "Secret", Namespace: "my-namespace", Name: "my-service"},
+			},
+		},
+		{
+			name: "selected services ordered by creation then name",
+			givenObjects: []client.Object{
+				service("a-green", now, map[string]string{"app": "db"}),
+				service("blue-b", earlier, map[string]string{"app": "db"}),
+				service("blue-a", earlier, map[string]string{"app": "db"}),
+				service("other", earlier, map[string]string{"app": "other"}),
+			},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+			},
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "db",
+				},
+			},
+			expected: []corev1.ObjectReference{
+				{APIVersion: "v1", Kind: "Secret", Namespace: "my-namespace", Name: "blue-a"},
+				{APIVersion: "v1", Kind: "Secret", Namespace: "my-namespace", Name: "blue-b"},
+				{APIVersion: "v1", Kind: "Secret", Namespace: "my-namespace", Name: "a-green"},
+			},
+		},
+		{
+			name:         "no selected services",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+			},
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "db",
+				},
+			},
+			expected: []corev1.ObjectReference{},
+		},
+		{
+			name:         "invalid selector",
+			givenObjects: []client.Object{},
+			serviceRef: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "my-namespace",
+			},
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "app",
+					Operator: "NotAnOperator",
+				}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			config := reconcilers.Config{
+				// secrets are not cached
+				Client:    rtesting.NewFakeClient(scheme),
+				APIReader: rtesting.NewFakeClient(scheme, c.givenObjects...),
+				Tracker:   tracker.New(0),
+			}
+			resolver := resolver.New(config)
+
+			actual, err := resolver.LookupServices(ctx, c.serviceRef, c.selector)
+
+			if (err != nil) != c.expectedErr {
+				t.Errorf("LookupServices() expected err: %v", err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("LookupServices() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestClusterResolver_LookupWorkloads(t *testing.T) {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -18,6 +18,7 @@ package resolver
 
 import (
 	"context"
+	"errors"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,18 +27,48 @@ import (
 	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
 )
 
+// ErrBindingSecretTemplate is wrapped by errors for binding secret entries that can not be rendered from the service
+var ErrBindingSecretTemplate = errors.New("unable to render binding secret")
+
 type Resolver interface {
-	// LookupMapping returns the mapping template for the workload. Typically a ClusterWorkloadResourceMapping is defined for the workload's
-	// fully qualified resource `{resource}.{group}`. The workload's version is either directly matched, or the wildcard version `*`
-	// mapping template is returned. If no explicit mapping is found, a mapping appropriate for a PodSpecable resource may be used.
+	// LookupMapping returns the mapping template for the workload. Typically a WorkloadResourceMapping in the workload's namespace or a
+	// ClusterWorkloadResourceMapping is defined for the workload's fully qualified resource `{resource}.{group}`, with the namespaced
+	// mapping taking precedence. Mappings from the built-in catalog are used when neither is defined. The mapping template whose
+	// version pattern best matches the workload's version is returned. If no explicit mapping is found, a mapping appropriate for a
+	// PodSpecable resource may be used.
 	LookupMapping(ctx context.Context, workload runtime.Object) (*servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, error)
 
 	// LookupBindingSecret returns the binding secret name exposed by the service following the Provisioned Service duck-type
 	// (`.status.binding.name`). If a direction binding is used (where the referenced service is itself a Secret) the referenced Secret is
-	// returned without a lookup.
+	// returned without a lookup, while an empty name is returned for a direct ConfigMap binding. A ClusterServiceResourceMapping for the service's resource takes precedence over the duck-type, an
+	// empty name is returned when the mapping synthesizes the binding secret.
 	LookupBindingSecret(ctx context.Context, serviceRef corev1.ObjectReference) (string, error)
 
+	// LookupBindingSecretData returns the entries of a binding secret synthesized from the fields of the service as described by a
+	// ClusterServiceResourceMapping. Nil is returned if the service's mapping does not synthesize a binding secret. Entries that can
+	// not be rendered from the service return an error wrapping ErrBindingSecretTemplate.
+	LookupBindingSecretData(ctx context.Context, serviceRef corev1.ObjectReference) (map[string][]byte, error)
+
+	// LookupBindingConfigMap returns the name of a config map with non-sensitive binding entries that are projected alongside the
+	// binding secret. A service may expose the config map at `.status.configMap.name`. If a direct binding is used (where the referenced
+	// service is itself a ConfigMap) the referenced ConfigMap is returned without a lookup. An empty name is returned when the service
+	// does not expose a config map.
+	LookupBindingConfigMap(ctx context.Context, serviceRef corev1.ObjectReference) (string, error)
+
+	// LookupServices returns references to the candidate services in order of preference. A named reference is returned without a
+	// lookup. Otherwise services in the namespace matching the selector are preferred by creation timestamp, oldest first, and then
+	// by name.
+	LookupServices(ctx context.Context, serviceRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]corev1.ObjectReference, error)
+
 	// LookupWorkloads returns the referenced objects. Often a unstructured Object is used to sidestep issues with schemes and registered
 	// types. The selector is mutually exclusive with the reference name.
 	LookupWorkloads(ctx context.Context, workloadRef corev1.ObjectReference, selector *metav1.LabelSelector) ([]runtime.Object, error)
 }
+
+// MappingSource is a layer of workload resource mappings consulted by LookupMapping. Sources are consulted in order, the first
+// source to define a mapping for the resource is used.
+type MappingSource interface {
+	// LookupMappingSpec returns the mapping for the workload resource named by `{resource}.{group}` applicable to workloads in the
+	// namespace, or nil if the source does not define a mapping for the resource.
+	LookupMappingSpec(ctx context.Context, namespace, name string) (*servicebindingv1beta1.ClusterWorkloadResourceMappingSpec, error)
+}
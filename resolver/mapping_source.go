@@ -0,0 +1,74 @@
+/*
+Copyright 2022 Scott Andrews.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+
+	"github.com/vmware-labs/reconciler-runtime/reconcilers"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	servicebindingv1beta1 "github.com/scothis/servicebinding-runtime/apis/v1beta1"
+	"github.com/scothis/servicebinding-runtime/catalog"
+)
+
+// namespacedMappingSource resolves WorkloadResourceMappings in the namespace of the workload
+type namespacedMappingSource struct {
+	config reconcilers.Config
+}
+
+func (s *namespacedMappingSource) LookupMappingSpec(ctx context.Context, namespace, name string) (*servicebindingv1beta1.ClusterWorkloadResourceMappingSpec, error) {
+	if namespace == "" {
+		return nil, nil
+	}
+	wrm := &servicebindingv1beta1.WorkloadResourceMapping{}
+	if err := s.config.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, wrm); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &wrm.Spec, nil
+}
+
+// clusterMappingSource resolves ClusterWorkloadResourceMappings
+type clusterMappingSource struct {
+	config reconcilers.Config
+}
+
+func (s *clusterMappingSource) LookupMappingSpec(ctx context.Context, namespace, name string) (*servicebindingv1beta1.ClusterWorkloadResourceMappingSpec, error) {
+	cwrm := &servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+	if err := s.config.Get(ctx, types.NamespacedName{Name: name}, cwrm); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &cwrm.Spec, nil
+}
+
+// catalogMappingSource resolves mappings from the built-in catalog
+type catalogMappingSource struct{}
+
+func (s *catalogMappingSource) LookupMappingSpec(ctx context.Context, namespace, name string) (*servicebindingv1beta1.ClusterWorkloadResourceMappingSpec, error) {
+	mapping := catalog.Lookup(name)
+	if mapping == nil {
+		return nil, nil
+	}
+	return &mapping.Spec, nil
+}